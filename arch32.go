@@ -0,0 +1,14 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                      zr-whirl/[arch32.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build 386 || arm || mips || mipsle
+// +build 386 arm mips mipsle
+
+package whirl
+
+// is32BitArch selects processBuffer32() on 32-bit platforms.
+const is32BitArch = true
+
+// end
@@ -0,0 +1,406 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                     zr-whirl/[block32.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Internal Functions
+//   processBuffer32(ob *Hash)
+//   splitTables() (hi, lo [8][256]uint32)
+//   tables32() (hi, lo [8][256]uint32)
+//   wipeWords32(ar *[8]uint32)
+//
+// -----------------------------------------------------------------------------
+//
+// On 32-bit platforms (386, arm, mips and mipsle), every uint64 table
+// lookup, XOR and shift in processBuffer() is compiled to a pair of
+// 32-bit operations, plus the work of moving the halves around.
+// processBuffer32() does the same computation on the high and low
+// halves of each word separately, using tables split into 32-bit
+// halves, so each byte index is extracted from a single 32-bit word.
+//
+// On 386 (measured with GOARCH=386 on an amd64 host), the Go compiler
+// already splits the 64-bit code well, and both paths hash at about
+// the same speed: Benchmark_bl32_ (64 KiB messages, medians of 8 runs
+// of 2 s) took 2.46 ms/op on the split path and 2.54 ms/op on the
+// 64-bit path, with a spread of about 30% between runs. The split
+// path is meant for ARM and MIPS, which have no 64-bit registers
+// either, but more of them to work with. They were not measured.
+//
+// The 32-bit path is selected at compile time: processBuffer() tests
+// the is32BitArch constant (see arch32.go and arch64.go), so on other
+// platforms the compiler drops the call, and the split tables are not
+// built. processBuffer32() is still compiled on every platform, so
+// that tests can compare it with the 64-bit path.
+
+// cC32Hi and cC32Lo are the high and low halves of tables cC0..cC7.
+// They are only built on 32-bit platforms; elsewhere, tests
+// build them with splitTables() before using processBuffer32().
+var cC32Hi, cC32Lo = tables32()
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// processBuffer32 does the same as processBuffer(),
+// working on 32-bit halves of the 64-bit words.
+// Output word i of each round takes byte j (counting from the most
+// significant byte) of input word i-j, and looks it up in table j.
+func processBuffer32(ob *Hash) {
+	var (
+		blockHi, blockLo [8]uint32 // mu(buffer)
+		stateHi, stateLo [8]uint32 // the cipher state
+		kHi, kLo         [8]uint32 // the round key
+		lHi, lLo         [8]uint32
+		buffer           = &ob.buffer
+		firstBlock       = useFirstBlockKeys &&
+			ob.hash == [cDigestBytes / 8]uint64{}
+	)
+	// map the buffer to a block, and apply K^0 to the cipher state:
+	for i := 0; i < 8; i++ {
+		b := buffer[i*8 : i*8+8]
+		blockHi[i] = uint32(b[0])<<24 | uint32(b[1])<<16 |
+			uint32(b[2])<<8 | uint32(b[3])
+		blockLo[i] = uint32(b[4])<<24 | uint32(b[5])<<16 |
+			uint32(b[6])<<8 | uint32(b[7])
+		kHi[i] = uint32(ob.hash[i] >> 32)
+		kLo[i] = uint32(ob.hash[i])
+		stateHi[i] = blockHi[i] ^ kHi[i]
+		stateLo[i] = blockLo[i] ^ kLo[i]
+	}
+	// iterate over all rounds:
+	for r := 1; r <= cRounds; r++ {
+		if firstBlock {
+			// use the precomputed round keys (see fastpath.go):
+			for i, k := range &firstBlockKeys[r-1] {
+				kHi[i] = uint32(k >> 32)
+				kLo[i] = uint32(k)
+			}
+		} else {
+			// compute K^r from K^{r-1}:
+			lHi[0] = cC32Hi[0][byte(kHi[0]>>24)] ^
+				cC32Hi[1][byte(kHi[7]>>16)] ^
+				cC32Hi[2][byte(kHi[6]>>8)] ^
+				cC32Hi[3][byte(kHi[5])] ^
+				cC32Hi[4][byte(kLo[4]>>24)] ^
+				cC32Hi[5][byte(kLo[3]>>16)] ^
+				cC32Hi[6][byte(kLo[2]>>8)] ^
+				cC32Hi[7][byte(kLo[1])] ^
+				uint32(rc[r]>>32)
+			lLo[0] = cC32Lo[0][byte(kHi[0]>>24)] ^
+				cC32Lo[1][byte(kHi[7]>>16)] ^
+				cC32Lo[2][byte(kHi[6]>>8)] ^
+				cC32Lo[3][byte(kHi[5])] ^
+				cC32Lo[4][byte(kLo[4]>>24)] ^
+				cC32Lo[5][byte(kLo[3]>>16)] ^
+				cC32Lo[6][byte(kLo[2]>>8)] ^
+				cC32Lo[7][byte(kLo[1])] ^
+				uint32(rc[r])
+			lHi[1] = cC32Hi[0][byte(kHi[1]>>24)] ^
+				cC32Hi[1][byte(kHi[0]>>16)] ^
+				cC32Hi[2][byte(kHi[7]>>8)] ^
+				cC32Hi[3][byte(kHi[6])] ^
+				cC32Hi[4][byte(kLo[5]>>24)] ^
+				cC32Hi[5][byte(kLo[4]>>16)] ^
+				cC32Hi[6][byte(kLo[3]>>8)] ^
+				cC32Hi[7][byte(kLo[2])]
+			lLo[1] = cC32Lo[0][byte(kHi[1]>>24)] ^
+				cC32Lo[1][byte(kHi[0]>>16)] ^
+				cC32Lo[2][byte(kHi[7]>>8)] ^
+				cC32Lo[3][byte(kHi[6])] ^
+				cC32Lo[4][byte(kLo[5]>>24)] ^
+				cC32Lo[5][byte(kLo[4]>>16)] ^
+				cC32Lo[6][byte(kLo[3]>>8)] ^
+				cC32Lo[7][byte(kLo[2])]
+			lHi[2] = cC32Hi[0][byte(kHi[2]>>24)] ^
+				cC32Hi[1][byte(kHi[1]>>16)] ^
+				cC32Hi[2][byte(kHi[0]>>8)] ^
+				cC32Hi[3][byte(kHi[7])] ^
+				cC32Hi[4][byte(kLo[6]>>24)] ^
+				cC32Hi[5][byte(kLo[5]>>16)] ^
+				cC32Hi[6][byte(kLo[4]>>8)] ^
+				cC32Hi[7][byte(kLo[3])]
+			lLo[2] = cC32Lo[0][byte(kHi[2]>>24)] ^
+				cC32Lo[1][byte(kHi[1]>>16)] ^
+				cC32Lo[2][byte(kHi[0]>>8)] ^
+				cC32Lo[3][byte(kHi[7])] ^
+				cC32Lo[4][byte(kLo[6]>>24)] ^
+				cC32Lo[5][byte(kLo[5]>>16)] ^
+				cC32Lo[6][byte(kLo[4]>>8)] ^
+				cC32Lo[7][byte(kLo[3])]
+			lHi[3] = cC32Hi[0][byte(kHi[3]>>24)] ^
+				cC32Hi[1][byte(kHi[2]>>16)] ^
+				cC32Hi[2][byte(kHi[1]>>8)] ^
+				cC32Hi[3][byte(kHi[0])] ^
+				cC32Hi[4][byte(kLo[7]>>24)] ^
+				cC32Hi[5][byte(kLo[6]>>16)] ^
+				cC32Hi[6][byte(kLo[5]>>8)] ^
+				cC32Hi[7][byte(kLo[4])]
+			lLo[3] = cC32Lo[0][byte(kHi[3]>>24)] ^
+				cC32Lo[1][byte(kHi[2]>>16)] ^
+				cC32Lo[2][byte(kHi[1]>>8)] ^
+				cC32Lo[3][byte(kHi[0])] ^
+				cC32Lo[4][byte(kLo[7]>>24)] ^
+				cC32Lo[5][byte(kLo[6]>>16)] ^
+				cC32Lo[6][byte(kLo[5]>>8)] ^
+				cC32Lo[7][byte(kLo[4])]
+			lHi[4] = cC32Hi[0][byte(kHi[4]>>24)] ^
+				cC32Hi[1][byte(kHi[3]>>16)] ^
+				cC32Hi[2][byte(kHi[2]>>8)] ^
+				cC32Hi[3][byte(kHi[1])] ^
+				cC32Hi[4][byte(kLo[0]>>24)] ^
+				cC32Hi[5][byte(kLo[7]>>16)] ^
+				cC32Hi[6][byte(kLo[6]>>8)] ^
+				cC32Hi[7][byte(kLo[5])]
+			lLo[4] = cC32Lo[0][byte(kHi[4]>>24)] ^
+				cC32Lo[1][byte(kHi[3]>>16)] ^
+				cC32Lo[2][byte(kHi[2]>>8)] ^
+				cC32Lo[3][byte(kHi[1])] ^
+				cC32Lo[4][byte(kLo[0]>>24)] ^
+				cC32Lo[5][byte(kLo[7]>>16)] ^
+				cC32Lo[6][byte(kLo[6]>>8)] ^
+				cC32Lo[7][byte(kLo[5])]
+			lHi[5] = cC32Hi[0][byte(kHi[5]>>24)] ^
+				cC32Hi[1][byte(kHi[4]>>16)] ^
+				cC32Hi[2][byte(kHi[3]>>8)] ^
+				cC32Hi[3][byte(kHi[2])] ^
+				cC32Hi[4][byte(kLo[1]>>24)] ^
+				cC32Hi[5][byte(kLo[0]>>16)] ^
+				cC32Hi[6][byte(kLo[7]>>8)] ^
+				cC32Hi[7][byte(kLo[6])]
+			lLo[5] = cC32Lo[0][byte(kHi[5]>>24)] ^
+				cC32Lo[1][byte(kHi[4]>>16)] ^
+				cC32Lo[2][byte(kHi[3]>>8)] ^
+				cC32Lo[3][byte(kHi[2])] ^
+				cC32Lo[4][byte(kLo[1]>>24)] ^
+				cC32Lo[5][byte(kLo[0]>>16)] ^
+				cC32Lo[6][byte(kLo[7]>>8)] ^
+				cC32Lo[7][byte(kLo[6])]
+			lHi[6] = cC32Hi[0][byte(kHi[6]>>24)] ^
+				cC32Hi[1][byte(kHi[5]>>16)] ^
+				cC32Hi[2][byte(kHi[4]>>8)] ^
+				cC32Hi[3][byte(kHi[3])] ^
+				cC32Hi[4][byte(kLo[2]>>24)] ^
+				cC32Hi[5][byte(kLo[1]>>16)] ^
+				cC32Hi[6][byte(kLo[0]>>8)] ^
+				cC32Hi[7][byte(kLo[7])]
+			lLo[6] = cC32Lo[0][byte(kHi[6]>>24)] ^
+				cC32Lo[1][byte(kHi[5]>>16)] ^
+				cC32Lo[2][byte(kHi[4]>>8)] ^
+				cC32Lo[3][byte(kHi[3])] ^
+				cC32Lo[4][byte(kLo[2]>>24)] ^
+				cC32Lo[5][byte(kLo[1]>>16)] ^
+				cC32Lo[6][byte(kLo[0]>>8)] ^
+				cC32Lo[7][byte(kLo[7])]
+			lHi[7] = cC32Hi[0][byte(kHi[7]>>24)] ^
+				cC32Hi[1][byte(kHi[6]>>16)] ^
+				cC32Hi[2][byte(kHi[5]>>8)] ^
+				cC32Hi[3][byte(kHi[4])] ^
+				cC32Hi[4][byte(kLo[3]>>24)] ^
+				cC32Hi[5][byte(kLo[2]>>16)] ^
+				cC32Hi[6][byte(kLo[1]>>8)] ^
+				cC32Hi[7][byte(kLo[0])]
+			lLo[7] = cC32Lo[0][byte(kHi[7]>>24)] ^
+				cC32Lo[1][byte(kHi[6]>>16)] ^
+				cC32Lo[2][byte(kHi[5]>>8)] ^
+				cC32Lo[3][byte(kHi[4])] ^
+				cC32Lo[4][byte(kLo[3]>>24)] ^
+				cC32Lo[5][byte(kLo[2]>>16)] ^
+				cC32Lo[6][byte(kLo[1]>>8)] ^
+				cC32Lo[7][byte(kLo[0])]
+			kHi, kLo = lHi, lLo
+		}
+		// apply the r-th round transformation:
+		lHi[0] = cC32Hi[0][byte(stateHi[0]>>24)] ^
+			cC32Hi[1][byte(stateHi[7]>>16)] ^
+			cC32Hi[2][byte(stateHi[6]>>8)] ^
+			cC32Hi[3][byte(stateHi[5])] ^
+			cC32Hi[4][byte(stateLo[4]>>24)] ^
+			cC32Hi[5][byte(stateLo[3]>>16)] ^
+			cC32Hi[6][byte(stateLo[2]>>8)] ^
+			cC32Hi[7][byte(stateLo[1])] ^
+			kHi[0]
+		lLo[0] = cC32Lo[0][byte(stateHi[0]>>24)] ^
+			cC32Lo[1][byte(stateHi[7]>>16)] ^
+			cC32Lo[2][byte(stateHi[6]>>8)] ^
+			cC32Lo[3][byte(stateHi[5])] ^
+			cC32Lo[4][byte(stateLo[4]>>24)] ^
+			cC32Lo[5][byte(stateLo[3]>>16)] ^
+			cC32Lo[6][byte(stateLo[2]>>8)] ^
+			cC32Lo[7][byte(stateLo[1])] ^
+			kLo[0]
+		lHi[1] = cC32Hi[0][byte(stateHi[1]>>24)] ^
+			cC32Hi[1][byte(stateHi[0]>>16)] ^
+			cC32Hi[2][byte(stateHi[7]>>8)] ^
+			cC32Hi[3][byte(stateHi[6])] ^
+			cC32Hi[4][byte(stateLo[5]>>24)] ^
+			cC32Hi[5][byte(stateLo[4]>>16)] ^
+			cC32Hi[6][byte(stateLo[3]>>8)] ^
+			cC32Hi[7][byte(stateLo[2])] ^
+			kHi[1]
+		lLo[1] = cC32Lo[0][byte(stateHi[1]>>24)] ^
+			cC32Lo[1][byte(stateHi[0]>>16)] ^
+			cC32Lo[2][byte(stateHi[7]>>8)] ^
+			cC32Lo[3][byte(stateHi[6])] ^
+			cC32Lo[4][byte(stateLo[5]>>24)] ^
+			cC32Lo[5][byte(stateLo[4]>>16)] ^
+			cC32Lo[6][byte(stateLo[3]>>8)] ^
+			cC32Lo[7][byte(stateLo[2])] ^
+			kLo[1]
+		lHi[2] = cC32Hi[0][byte(stateHi[2]>>24)] ^
+			cC32Hi[1][byte(stateHi[1]>>16)] ^
+			cC32Hi[2][byte(stateHi[0]>>8)] ^
+			cC32Hi[3][byte(stateHi[7])] ^
+			cC32Hi[4][byte(stateLo[6]>>24)] ^
+			cC32Hi[5][byte(stateLo[5]>>16)] ^
+			cC32Hi[6][byte(stateLo[4]>>8)] ^
+			cC32Hi[7][byte(stateLo[3])] ^
+			kHi[2]
+		lLo[2] = cC32Lo[0][byte(stateHi[2]>>24)] ^
+			cC32Lo[1][byte(stateHi[1]>>16)] ^
+			cC32Lo[2][byte(stateHi[0]>>8)] ^
+			cC32Lo[3][byte(stateHi[7])] ^
+			cC32Lo[4][byte(stateLo[6]>>24)] ^
+			cC32Lo[5][byte(stateLo[5]>>16)] ^
+			cC32Lo[6][byte(stateLo[4]>>8)] ^
+			cC32Lo[7][byte(stateLo[3])] ^
+			kLo[2]
+		lHi[3] = cC32Hi[0][byte(stateHi[3]>>24)] ^
+			cC32Hi[1][byte(stateHi[2]>>16)] ^
+			cC32Hi[2][byte(stateHi[1]>>8)] ^
+			cC32Hi[3][byte(stateHi[0])] ^
+			cC32Hi[4][byte(stateLo[7]>>24)] ^
+			cC32Hi[5][byte(stateLo[6]>>16)] ^
+			cC32Hi[6][byte(stateLo[5]>>8)] ^
+			cC32Hi[7][byte(stateLo[4])] ^
+			kHi[3]
+		lLo[3] = cC32Lo[0][byte(stateHi[3]>>24)] ^
+			cC32Lo[1][byte(stateHi[2]>>16)] ^
+			cC32Lo[2][byte(stateHi[1]>>8)] ^
+			cC32Lo[3][byte(stateHi[0])] ^
+			cC32Lo[4][byte(stateLo[7]>>24)] ^
+			cC32Lo[5][byte(stateLo[6]>>16)] ^
+			cC32Lo[6][byte(stateLo[5]>>8)] ^
+			cC32Lo[7][byte(stateLo[4])] ^
+			kLo[3]
+		lHi[4] = cC32Hi[0][byte(stateHi[4]>>24)] ^
+			cC32Hi[1][byte(stateHi[3]>>16)] ^
+			cC32Hi[2][byte(stateHi[2]>>8)] ^
+			cC32Hi[3][byte(stateHi[1])] ^
+			cC32Hi[4][byte(stateLo[0]>>24)] ^
+			cC32Hi[5][byte(stateLo[7]>>16)] ^
+			cC32Hi[6][byte(stateLo[6]>>8)] ^
+			cC32Hi[7][byte(stateLo[5])] ^
+			kHi[4]
+		lLo[4] = cC32Lo[0][byte(stateHi[4]>>24)] ^
+			cC32Lo[1][byte(stateHi[3]>>16)] ^
+			cC32Lo[2][byte(stateHi[2]>>8)] ^
+			cC32Lo[3][byte(stateHi[1])] ^
+			cC32Lo[4][byte(stateLo[0]>>24)] ^
+			cC32Lo[5][byte(stateLo[7]>>16)] ^
+			cC32Lo[6][byte(stateLo[6]>>8)] ^
+			cC32Lo[7][byte(stateLo[5])] ^
+			kLo[4]
+		lHi[5] = cC32Hi[0][byte(stateHi[5]>>24)] ^
+			cC32Hi[1][byte(stateHi[4]>>16)] ^
+			cC32Hi[2][byte(stateHi[3]>>8)] ^
+			cC32Hi[3][byte(stateHi[2])] ^
+			cC32Hi[4][byte(stateLo[1]>>24)] ^
+			cC32Hi[5][byte(stateLo[0]>>16)] ^
+			cC32Hi[6][byte(stateLo[7]>>8)] ^
+			cC32Hi[7][byte(stateLo[6])] ^
+			kHi[5]
+		lLo[5] = cC32Lo[0][byte(stateHi[5]>>24)] ^
+			cC32Lo[1][byte(stateHi[4]>>16)] ^
+			cC32Lo[2][byte(stateHi[3]>>8)] ^
+			cC32Lo[3][byte(stateHi[2])] ^
+			cC32Lo[4][byte(stateLo[1]>>24)] ^
+			cC32Lo[5][byte(stateLo[0]>>16)] ^
+			cC32Lo[6][byte(stateLo[7]>>8)] ^
+			cC32Lo[7][byte(stateLo[6])] ^
+			kLo[5]
+		lHi[6] = cC32Hi[0][byte(stateHi[6]>>24)] ^
+			cC32Hi[1][byte(stateHi[5]>>16)] ^
+			cC32Hi[2][byte(stateHi[4]>>8)] ^
+			cC32Hi[3][byte(stateHi[3])] ^
+			cC32Hi[4][byte(stateLo[2]>>24)] ^
+			cC32Hi[5][byte(stateLo[1]>>16)] ^
+			cC32Hi[6][byte(stateLo[0]>>8)] ^
+			cC32Hi[7][byte(stateLo[7])] ^
+			kHi[6]
+		lLo[6] = cC32Lo[0][byte(stateHi[6]>>24)] ^
+			cC32Lo[1][byte(stateHi[5]>>16)] ^
+			cC32Lo[2][byte(stateHi[4]>>8)] ^
+			cC32Lo[3][byte(stateHi[3])] ^
+			cC32Lo[4][byte(stateLo[2]>>24)] ^
+			cC32Lo[5][byte(stateLo[1]>>16)] ^
+			cC32Lo[6][byte(stateLo[0]>>8)] ^
+			cC32Lo[7][byte(stateLo[7])] ^
+			kLo[6]
+		lHi[7] = cC32Hi[0][byte(stateHi[7]>>24)] ^
+			cC32Hi[1][byte(stateHi[6]>>16)] ^
+			cC32Hi[2][byte(stateHi[5]>>8)] ^
+			cC32Hi[3][byte(stateHi[4])] ^
+			cC32Hi[4][byte(stateLo[3]>>24)] ^
+			cC32Hi[5][byte(stateLo[2]>>16)] ^
+			cC32Hi[6][byte(stateLo[1]>>8)] ^
+			cC32Hi[7][byte(stateLo[0])] ^
+			kHi[7]
+		lLo[7] = cC32Lo[0][byte(stateHi[7]>>24)] ^
+			cC32Lo[1][byte(stateHi[6]>>16)] ^
+			cC32Lo[2][byte(stateHi[5]>>8)] ^
+			cC32Lo[3][byte(stateHi[4])] ^
+			cC32Lo[4][byte(stateLo[3]>>24)] ^
+			cC32Lo[5][byte(stateLo[2]>>16)] ^
+			cC32Lo[6][byte(stateLo[1]>>8)] ^
+			cC32Lo[7][byte(stateLo[0])] ^
+			kLo[7]
+		stateHi, stateLo = lHi, lLo
+	}
+	// apply the Miyaguchi-Preneel compression function:
+	for i := 0; i < 8; i++ {
+		ob.hash[i] ^= uint64(stateHi[i]^blockHi[i])<<32 |
+			uint64(stateLo[i]^blockLo[i])
+	}
+	// clear the round keys and cipher state from the stack:
+	wipeWords32(&blockHi)
+	wipeWords32(&blockLo)
+	wipeWords32(&stateHi)
+	wipeWords32(&stateLo)
+	wipeWords32(&kHi)
+	wipeWords32(&kLo)
+	wipeWords32(&lHi)
+	wipeWords32(&lLo)
+} //                                                             processBuffer32
+
+// tables32 returns the split tables on 32-bit platforms,
+// and empty tables on other platforms.
+func tables32() (hi, lo [8][256]uint32) {
+	if is32BitArch {
+		return splitTables()
+	}
+	return hi, lo
+} //                                                                    tables32
+
+// splitTables returns the high and low halves of tables cC0..cC7.
+func splitTables() (hi, lo [8][256]uint32) {
+	tables := [8]*[256]uint64{&cC0, &cC1, &cC2, &cC3, &cC4, &cC5, &cC6, &cC7}
+	for t, table := range tables {
+		for i, n := range table {
+			hi[t][i] = uint32(n >> 32)
+			lo[t][i] = uint32(n)
+		}
+	}
+	return hi, lo
+} //                                                                 splitTables
+
+// wipeWords32 clears an array of 32-bit words, like wipeWords().
+//
+//go:noinline
+func wipeWords32(ar *[8]uint32) {
+	*ar = [8]uint32{}
+} //                                                                 wipeWords32
+
+// end
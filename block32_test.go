@@ -0,0 +1,146 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                zr-whirl/[block32_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+//  to test all items in block32.go use:
+//      go test --run Test_bl32_
+//
+//  the tests call processBuffer32() directly, so they check the
+//  32-bit path on every platform. On a 32-bit platform, which
+//  can be tested on amd64 by using GOARCH=386 go test, all the
+//  other tests use the 32-bit path as well.
+//
+//  to compare the 32-bit and 64-bit paths use:
+//      GOARCH=386 go test --run - --bench Benchmark_bl32_
+
+// go test --run Test_bl32_ISO_
+func Test_bl32_ISO_(t *testing.T) {
+	cC32Hi, cC32Lo = splitTables()
+	for i, v := range selfTestVectors {
+		digest := sumBlocks(processBuffer32, [8]uint64{}, []byte(v.input))
+		got := hex.EncodeToString(digest[:])
+		if !strings.EqualFold(got, v.expect) {
+			t.Errorf("ISO vector %d FAILED", i+1)
+		}
+	}
+	data := []byte(strings.Repeat("a", 1000000))
+	digest := sumBlocks(processBuffer32, [8]uint64{}, data)
+	got := hex.EncodeToString(digest[:])
+	if got != "0c99005beb57eff50a7cf005560ddf5d"+
+		"29057fd86b20bfd62deca0f1ccea4af5"+
+		"1fc15490eddc47af32bb2b66c34ff9ad"+
+		"8c6008ad677f77126953b226e4ed8b01" {
+		t.Errorf("ISO vector 9 FAILED")
+	}
+} //                                                              Test_bl32_ISO_
+
+// go test --run Test_bl32_Emulation_
+func Test_bl32_Emulation_(t *testing.T) {
+	cC32Hi, cC32Lo = splitTables()
+	// every message length up to three blocks, with and without the
+	// first-block keys, and with a nonzero initial state, must give
+	// the same digest as the 64-bit path
+	data := make([]byte, 3*cWBlockBytes)
+	for i := range data {
+		data[i] = byte(i*131 + 17)
+	}
+	defer func() { useFirstBlockKeys = true }()
+	for _, p := range []string{"", "personalized"} {
+		iv := NewPersonalized(p).iv
+		for _, firstKeys := range []bool{true, false} {
+			for n := 0; n <= len(data); n++ {
+				useFirstBlockKeys = false
+				expect := sumBlocks(processBuffer64, iv, data[:n])
+				useFirstBlockKeys = firstKeys
+				got := sumBlocks(processBuffer32, iv, data[:n])
+				if got != expect {
+					t.Errorf("p=%q firstKeys=%v length %d:"+
+						" 32-bit path returned a wrong digest",
+						p, firstKeys, n)
+				}
+			}
+		}
+	}
+	// the split tables must join back into the 64-bit tables
+	tables := [8]*[256]uint64{&cC0, &cC1, &cC2, &cC3, &cC4, &cC5, &cC6, &cC7}
+	for t2, table := range tables {
+		for i, n := range table {
+			if uint64(cC32Hi[t2][i])<<32|uint64(cC32Lo[t2][i]) != n {
+				t.Fatalf("split table %d entry %d differs", t2, i)
+			}
+		}
+	}
+} //                                                        Test_bl32_Emulation_
+
+// go test --run Test_bl32_Wipe_
+func Test_bl32_Wipe_(t *testing.T) {
+	words := [8]uint32{1, 2, 3, 4, 5, 6, 7, 8}
+	wipeWords32(&words)
+	if words != [8]uint32{} {
+		t.Errorf("wipeWords32 did not clear the array")
+	}
+} //                                                             Test_bl32_Wipe_
+
+// sumBlocks pads 'data' and hashes it one block at a time with
+// 'process' (processBuffer32 or processBuffer64), starting from
+// the chaining state 'iv'. It returns the whole 64-byte state.
+func sumBlocks(
+	process func(*Hash),
+	iv [8]uint64,
+	data []byte,
+) (digest [cDigestBytes]byte) {
+	// append a 1-bit, zero bits up to 32 bytes before the end
+	// of a block, and the 256-bit length in bits
+	msg := append(append([]byte{}, data...), 0x80)
+	for len(msg)%cWBlockBytes != cWBlockBytes-cLengthBytes {
+		msg = append(msg, 0)
+	}
+	var length [cLengthBytes]byte
+	for i, n := cLengthBytes-1, uint64(8*len(data)); n > 0; i-- {
+		length[i] = byte(n)
+		n >>= 8
+	}
+	msg = append(msg, length[:]...)
+	h := Hash{hash: iv}
+	for len(msg) > 0 {
+		copy(h.buffer[:], msg)
+		process(&h)
+		msg = msg[cWBlockBytes:]
+	}
+	writeDigest(&h, digest[:])
+	return digest
+} //                                                                   sumBlocks
+
+// -----------------------------------------------------------------------------
+// # Benchmarks
+
+// go test --run - --bench Benchmark_bl32_
+func Benchmark_bl32_(b *testing.B) {
+	cC32Hi, cC32Lo = splitTables()
+	for _, process := range []struct {
+		name string
+		fn   func(*Hash)
+	}{
+		{"32-bit", processBuffer32},
+		{"64-bit", processBuffer64},
+	} {
+		b.Run(process.name, func(b *testing.B) {
+			h := Hash{hash: iv256} // not the first block
+			b.SetBytes(cWBlockBytes)
+			for i := 0; i < b.N; i++ {
+				process.fn(&h)
+			}
+		})
+	}
+} //                                                             Benchmark_bl32_
+
+// end
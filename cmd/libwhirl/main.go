@@ -0,0 +1,236 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package           zr-whirl/[cmd/libwhirl/main.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Command libwhirl builds the Whirlpool hash as a C shared library,
+// so that C services (and anything that can call C, such as Python's
+// ctypes) produce the same digests as the Go package:
+//
+//	go build -buildmode=c-shared -o libwhirl.so ./cmd/libwhirl
+//
+// This also writes libwhirl.h, which is kept in this directory.
+// The caller owns every buffer: data, digests and the hashing
+// contexts, which are plain structs that can live on the stack.
+// Digests are always WHIRL_DIGEST_SIZE (64) bytes.
+package main
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+
+#define WHIRL_DIGEST_SIZE 64
+#define WHIRL_BLOCK_SIZE  64
+
+// whirl_ctx holds the state of a Whirlpool hash.
+// Its contents are private to the library.
+typedef struct {
+	uint64_t opaque[64];
+} whirl_ctx;
+
+// whirl_hmac_ctx holds the state of an HMAC-Whirlpool computation.
+typedef struct {
+	whirl_ctx inner;
+	whirl_ctx outer;
+} whirl_hmac_ctx;
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// # Contents:
+//
+// # Hash Functions
+//   whirl_sum512(data unsafe.Pointer, n C.size_t, out *C.uint8_t)
+//   whirl_init(ctx *C.whirl_ctx)
+//   whirl_update(ctx *C.whirl_ctx, data unsafe.Pointer, n C.size_t) C.int
+//   whirl_final(ctx *C.whirl_ctx, out *C.uint8_t) C.int
+//
+// # HMAC Functions
+//   whirl_hmac(key unsafe.Pointer, keyLen C.size_t,
+//       data unsafe.Pointer, n C.size_t, out *C.uint8_t)
+//   whirl_hmac_init(ctx *C.whirl_hmac_ctx, key unsafe.Pointer,
+//       keyLen C.size_t)
+//   whirl_hmac_update(ctx *C.whirl_hmac_ctx, data unsafe.Pointer,
+//       n C.size_t) C.int
+//   whirl_hmac_final(ctx *C.whirl_hmac_ctx, out *C.uint8_t) C.int
+//
+// # Helper Functions
+//   bytesOf(data unsafe.Pointer, n C.size_t) []byte
+//   digestOf(out *C.uint8_t) []byte
+//   contextOf(ctx *C.whirl_ctx) *context
+//   hashOf(ctx *C.whirl_ctx) *whirl.Hash
+
+// context is what a whirl_ctx holds: the Go hash, and whether
+// whirl_final() has wiped it, so that it refuses updates until
+// whirl_init() is called again.
+type context struct {
+	hash  whirl.Hash
+	final bool
+} //                                                                     context
+
+// context holds no Go pointers, so it can be stored in C memory.
+// This fails to compile if whirl_ctx becomes too small to hold it.
+const _ = C.sizeof_whirl_ctx - unsafe.Sizeof(context{})
+
+// -----------------------------------------------------------------------------
+// # Hash Functions
+
+// whirl_sum512 writes the digest of 'n' bytes at 'data' to 'out'.
+//
+//export whirl_sum512
+func whirl_sum512(data unsafe.Pointer, n C.size_t, out *C.uint8_t) {
+	digest := whirl.Sum512(bytesOf(data, n))
+	copy(digestOf(out), digest[:])
+} //                                                                whirl_sum512
+
+// whirl_init prepares 'ctx' for hashing a new message.
+//
+//export whirl_init
+func whirl_init(ctx *C.whirl_ctx) {
+	*contextOf(ctx) = context{hash: whirl.New()}
+} //                                                                  whirl_init
+
+// whirl_update adds 'n' bytes at 'data' to the message. It returns 0,
+// or -1 if 'ctx' has been finalized and not initialized again.
+//
+//export whirl_update
+func whirl_update(ctx *C.whirl_ctx, data unsafe.Pointer, n C.size_t) C.int {
+	if contextOf(ctx).final {
+		return -1
+	}
+	_, err := hashOf(ctx).Write(bytesOf(data, n))
+	if err != nil {
+		return -1
+	}
+	return 0
+} //                                                                whirl_update
+
+// whirl_final writes the digest of the message to 'out' and clears
+// 'ctx'. It returns 0, or -1 if 'ctx' has already been finalized.
+//
+//export whirl_final
+func whirl_final(ctx *C.whirl_ctx, out *C.uint8_t) C.int {
+	c := contextOf(ctx)
+	if c.final {
+		return -1
+	}
+	c.hash.Finalize(digestOf(out)[:0])
+	c.hash.Zeroize()
+	c.final = true // refuse updates until whirl_init()
+	return 0
+} //                                                                 whirl_final
+
+// -----------------------------------------------------------------------------
+// # HMAC Functions
+
+// whirl_hmac writes the HMAC-Whirlpool of 'n' bytes at 'data',
+// using a key of 'keyLen' bytes at 'key', to 'out'.
+//
+//export whirl_hmac
+func whirl_hmac(
+	key unsafe.Pointer,
+	keyLen C.size_t,
+	data unsafe.Pointer,
+	n C.size_t,
+	out *C.uint8_t,
+) {
+	var ctx C.whirl_hmac_ctx
+	whirl_hmac_init(&ctx, key, keyLen)
+	whirl_hmac_update(&ctx, data, n)
+	whirl_hmac_final(&ctx, out)
+} //                                                                  whirl_hmac
+
+// whirl_hmac_init prepares 'ctx' for computing the HMAC
+// of a new message with a key of 'keyLen' bytes at 'key'.
+//
+//export whirl_hmac_init
+func whirl_hmac_init(
+	ctx *C.whirl_hmac_ctx,
+	key unsafe.Pointer,
+	keyLen C.size_t,
+) {
+	var pad [C.WHIRL_BLOCK_SIZE]byte
+	if keyLen > C.WHIRL_BLOCK_SIZE {
+		digest := whirl.Sum512(bytesOf(key, keyLen))
+		copy(pad[:], digest[:])
+	} else {
+		copy(pad[:], bytesOf(key, keyLen))
+	}
+	whirl_init(&ctx.inner)
+	whirl_init(&ctx.outer)
+	inner, outer := hashOf(&ctx.inner), hashOf(&ctx.outer)
+	for i := range pad {
+		pad[i] ^= 0x36
+	}
+	inner.Write(pad[:])
+	for i := range pad {
+		pad[i] ^= 0x36 ^ 0x5C
+	}
+	outer.Write(pad[:])
+	for i := range pad {
+		pad[i] = 0
+	}
+} //                                                             whirl_hmac_init
+
+// whirl_hmac_update adds 'n' bytes at 'data' to the message. It returns
+// 0, or -1 if 'ctx' has been finalized and not initialized again.
+//
+//export whirl_hmac_update
+func whirl_hmac_update(
+	ctx *C.whirl_hmac_ctx,
+	data unsafe.Pointer,
+	n C.size_t,
+) C.int {
+	return whirl_update(&ctx.inner, data, n)
+} //                                                           whirl_hmac_update
+
+// whirl_hmac_final writes the HMAC of the message to 'out' and clears
+// 'ctx'. It returns 0, or -1 if 'ctx' has already been finalized.
+//
+//export whirl_hmac_final
+func whirl_hmac_final(ctx *C.whirl_hmac_ctx, out *C.uint8_t) C.int {
+	var digest [C.WHIRL_DIGEST_SIZE]C.uint8_t
+	if whirl_final(&ctx.inner, &digest[0]) != 0 {
+		return -1
+	}
+	whirl_update(&ctx.outer, unsafe.Pointer(&digest[0]), C.WHIRL_DIGEST_SIZE)
+	for i := range digest {
+		digest[i] = 0
+	}
+	return whirl_final(&ctx.outer, out)
+} //                                                            whirl_hmac_final
+
+// -----------------------------------------------------------------------------
+// # Helper Functions
+
+// bytesOf returns a slice over 'n' bytes of C memory at 'data'.
+func bytesOf(data unsafe.Pointer, n C.size_t) []byte {
+	if n == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(data), n)
+} //                                                                     bytesOf
+
+// digestOf returns a slice over the digest buffer at 'out'.
+func digestOf(out *C.uint8_t) []byte {
+	return (*[C.WHIRL_DIGEST_SIZE]byte)(unsafe.Pointer(out))[:]
+} //                                                                    digestOf
+
+// contextOf returns the Go context stored in 'ctx'.
+func contextOf(ctx *C.whirl_ctx) *context {
+	return (*context)(unsafe.Pointer(ctx))
+} //                                                                   contextOf
+
+// hashOf returns the Go hash stored in 'ctx'.
+func hashOf(ctx *C.whirl_ctx) *whirl.Hash {
+	return &contextOf(ctx).hash
+} //                                                                      hashOf
+
+func main() {}
+
+// end
@@ -0,0 +1,116 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package      zr-whirl/[cmd/libwhirl/main_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in main.go use:
+//      go test --run Test_libw_
+//
+//  the tests build the shared library and compile
+//  testdata/whirl_test.c, so they need cgo and gcc.
+
+// go test --run Test_libw_C_
+func Test_libw_C_(t *testing.T) {
+	dir := buildLibrary(t)
+	//
+	// the committed header must be the one cgo generates
+	header, err := ioutil.ReadFile(filepath.Join(dir, "libwhirl.h"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := ioutil.ReadFile("libwhirl.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(header, committed) {
+		t.Errorf("libwhirl.h is out of date; regenerate it with:\n" +
+			"    go build -buildmode=c-shared -o libwhirl.so .")
+	}
+	// compile and run the C test, which checks the ISO vectors
+	// and prints the HMACs of the key and message pairs below
+	prog := filepath.Join(dir, "whirl_test")
+	run(t, "gcc", "-Wall", "-Werror", "-o", prog,
+		filepath.Join("testdata", "whirl_test.c"),
+		"-I", dir, "-L", dir, "-lwhirl", "-Wl,-rpath,"+dir)
+	args := []string{
+		"key", "The quick brown fox jumps over the lazy dog",
+		"", "",
+		strings.Repeat("k", 64), "a block-sized key",
+		strings.Repeat("long key ", 20), "a key longer than a block",
+	}
+	output := run(t, prog, args...)
+	lines := strings.Fields(output)
+	if len(lines) != len(args)/2 {
+		t.Fatalf("expected %d HMACs, got:\n%s", len(args)/2, output)
+	}
+	newHash := func() hash.Hash {
+		h := whirl.New()
+		return &h
+	}
+	for i := 0; i < len(args); i += 2 {
+		mac := hmac.New(newHash, []byte(args[i]))
+		mac.Write([]byte(args[i+1]))
+		expect := strings.ToUpper(hex.EncodeToString(mac.Sum(nil)))
+		if lines[i/2] != expect {
+			t.Errorf("HMAC %d:\n got    %s\n expect %s",
+				i/2+1, lines[i/2], expect)
+		}
+	}
+} //                                                                Test_libw_C_
+
+// buildLibrary builds libwhirl.so and libwhirl.h in a temporary
+// directory and returns its path. It skips the test if cgo
+// or gcc are not available.
+func buildLibrary(t *testing.T) string {
+	if testing.Short() {
+		t.Skip("skipping C build in short mode")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("gcc not found")
+	}
+	out, err := exec.Command("go", "env", "CGO_ENABLED").Output()
+	if err != nil || strings.TrimSpace(string(out)) != "1" {
+		t.Skip("cgo is disabled")
+	}
+	dir, err := ioutil.TempDir("", "libwhirl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	run(t, "go", "build", "-buildmode=c-shared",
+		"-o", filepath.Join(dir, "libwhirl.so"), ".")
+	return dir
+} //                                                                buildLibrary
+
+// run runs a command and returns its standard output.
+// It fails the test if the command fails.
+func run(t *testing.T, name string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command(name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("%s failed: %v\n%s%s", name, err, out, stderr.Bytes())
+	}
+	return string(out)
+} //                                                                         run
+
+// end
@@ -0,0 +1,47 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package      zr-whirl/[cmd/libwhirl/mmap_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build cgo && (linux || darwin)
+// +build cgo
+// +build linux darwin
+
+package main
+
+import (
+	"syscall"
+	"testing"
+	"unsafe"
+)
+
+//  to test all items in mmap_test.go use:
+//      go test --run Test_libw_BytesOf_
+
+// go test --run Test_libw_BytesOf_
+func Test_libw_BytesOf_(t *testing.T) {
+	// bytesOf used to slice a 1 GiB array type, which panicked
+	// (and killed the C host) for any buffer larger than that.
+	// The buffer is mapped lazily, so only the touched pages
+	// are backed by memory.
+	const n = 1<<30 + 4096
+	mem, err := syscall.Mmap(-1, 0, n, syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		t.Skip("can't map a buffer of", n, "bytes:", err)
+	}
+	defer syscall.Munmap(mem)
+	mem[0], mem[n-1] = 0x5A, 0xA5
+	got := bytesOf(unsafe.Pointer(&mem[0]), n)
+	if len(got) != n || cap(got) != n {
+		t.Fatalf("got len %d cap %d, expected %d", len(got), cap(got), n)
+	}
+	if got[0] != 0x5A || got[n-1] != 0xA5 {
+		t.Errorf("bytesOf doesn't cover the buffer")
+	}
+	if bytesOf(unsafe.Pointer(&mem[0]), 0) != nil {
+		t.Errorf("expected nil for an empty buffer")
+	}
+} //                                                          Test_libw_BytesOf_
+
+// end
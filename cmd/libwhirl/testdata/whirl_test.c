@@ -0,0 +1,140 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                   zr-whirl/[whirl_test.c]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Checks libwhirl against the ISO/IEC 10118-3 test vectors, then
+// prints the HMACs of the keys and messages given as arguments
+// (in pairs), one per line, for main_test.go to check.
+// Exits with status 1 if any check fails.
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include "libwhirl.h"
+
+static const struct {
+	const char *input;
+	const char *expect;
+} vectors[] = {
+	{"",
+	 "19FA61D75522A4669B44E39C1D2E1726C530232130D407F89AFEE0964997F7A7"
+	 "3E83BE698B288FEBCF88E3E03C4F0757EA8964E59B63D93708B138CC42A66EB3"},
+	{"a",
+	 "8ACA2602792AEC6F11A67206531FB7D7F0DFF59413145E6973C45001D0087B42"
+	 "D11BC645413AEFF63A42391A39145A591A92200D560195E53B478584FDAE231A"},
+	{"abc",
+	 "4E2448A4C6F486BB16B6562C73B4020BF3043E3A731BCE721AE1B303D97E6D4C"
+	 "7181EEBDB6C57E277D0E34957114CBD6C797FC9D95D8B582D225292076D4EEF5"},
+	{"message digest",
+	 "378C84A4126E2DC6E56DCC7458377AAC838D00032230F53CE1F5700C0FFB4D3B"
+	 "8421557659EF55C106B4B52AC5A4AAA692ED920052838F3362E86DBD37A8903E"},
+	{"abcdefghijklmnopqrstuvwxyz",
+	 "F1D754662636FFE92C82EBB9212A484A8D38631EAD4238F5442EE13B8054E41B"
+	 "08BF2A9251C30B6A0B8AAE86177AB4A6F68F673E7207865D5D9819A3DBA4EB3B"},
+	{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	 "DC37E008CF9EE69BF11F00ED9ABA26901DD7C28CDEC066CC6AF42E40F82F3A1E"
+	 "08EBA26629129D8FB7CB57211B9281A65517CC879D7B962142C65F5A7AF01467"},
+	{"1234567890123456789012345678901234567890"
+	 "1234567890123456789012345678901234567890",
+	 "466EF18BABB0154D25B9D38A6414F5C08784372BCCB204D6549C4AFADB601429"
+	 "4D5BD8DF2A6C44E538CD047B2681A51A2C60481E88C5A20B2C2A80CF3A9A083B"},
+	{"abcdbcdecdefdefgefghfghighijhijk",
+	 "2A987EA40F917061F5D6F0A0E4644F488A7A5A52DEEE656207C562F988E95C69"
+	 "16BDC8031BC5BE1B7B947639FE050B56939BAAA0ADFF9AE6745B7B181C3BE3FD"},
+};
+
+static const char *million_a =
+	"0C99005BEB57EFF50A7CF005560DDF5D29057FD86B20BFD62DECA0F1CCEA4AF5"
+	"1FC15490EDDC47AF32BB2B66C34FF9AD8C6008AD677F77126953B226E4ED8B01";
+
+static int failed = 0;
+
+// to_hex writes a digest as uppercase hex digits.
+static void to_hex(const uint8_t *digest, char *out) {
+	for (int i = 0; i < WHIRL_DIGEST_SIZE; i++) {
+		sprintf(out + 2 * i, "%02X", digest[i]);
+	}
+}
+
+// check reports a digest that differs from the expected hex digits.
+static void check(const char *name, int n, const uint8_t *digest,
+                  const char *expect) {
+	char got[2 * WHIRL_DIGEST_SIZE + 1];
+	to_hex(digest, got);
+	if (strcmp(got, expect) != 0) {
+		fprintf(stderr, "%s %d FAILED:\n  got    %s\n  expect %s\n",
+		        name, n, got, expect);
+		failed = 1;
+	}
+}
+
+int main(int argc, char **argv) {
+	uint8_t digest[WHIRL_DIGEST_SIZE];
+	whirl_ctx ctx;
+	int count = (int)(sizeof(vectors) / sizeof(vectors[0]));
+
+	// one-shot and streaming (a byte at a time) hashing
+	for (int i = 0; i < count; i++) {
+		const char *input = vectors[i].input;
+		size_t n = strlen(input);
+		whirl_sum512((void *)input, n, digest);
+		check("whirl_sum512 vector", i + 1, digest, vectors[i].expect);
+
+		whirl_init(&ctx);
+		for (size_t j = 0; j < n; j++) {
+			whirl_update(&ctx, (void *)(input + j), 1);
+		}
+		if (whirl_final(&ctx, digest) != 0) {
+			fprintf(stderr, "whirl_final %d failed\n", i + 1);
+			failed = 1;
+		}
+		check("streaming vector", i + 1, digest, vectors[i].expect);
+	}
+
+	// vector 9: a million times 'a', in uneven chunks
+	char chunk[1000];
+	memset(chunk, 'a', sizeof(chunk));
+	whirl_init(&ctx);
+	for (size_t done = 0; done < 1000000;) {
+		size_t n = 1 + done % 997;
+		if (n > 1000000 - done) {
+			n = 1000000 - done;
+		}
+		whirl_update(&ctx, chunk, n);
+		done += n;
+	}
+	whirl_final(&ctx, digest);
+	check("streaming vector", 9, digest, million_a);
+
+	// a finalized context refuses more data until it is initialized
+	if (whirl_update(&ctx, "x", 1) != -1 || whirl_final(&ctx, digest) != -1) {
+		fprintf(stderr, "finalized context accepted more data\n");
+		failed = 1;
+	}
+
+	// HMACs of the arguments: one-shot and streaming must agree
+	for (int i = 1; i + 1 < argc; i += 2) {
+		const char *key = argv[i], *msg = argv[i + 1];
+		uint8_t streamed[WHIRL_DIGEST_SIZE];
+		whirl_hmac_ctx hctx;
+		char hex[2 * WHIRL_DIGEST_SIZE + 1];
+
+		whirl_hmac((void *)key, strlen(key), (void *)msg, strlen(msg), digest);
+		whirl_hmac_init(&hctx, (void *)key, strlen(key));
+		for (size_t j = 0; msg[j] != 0; j++) {
+			whirl_hmac_update(&hctx, (void *)(msg + j), 1);
+		}
+		whirl_hmac_final(&hctx, streamed);
+		if (memcmp(digest, streamed, WHIRL_DIGEST_SIZE) != 0) {
+			fprintf(stderr, "streaming HMAC %d differs\n", i / 2 + 1);
+			failed = 1;
+		}
+		to_hex(digest, hex);
+		printf("%s\n", hex);
+	}
+	return failed;
+}
+
+// end
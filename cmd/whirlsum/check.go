@@ -0,0 +1,253 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package          zr-whirl/[cmd/whirlsum/check.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package main
+
+// Check mode reads lists of checksums and verifies them, like
+// 'sha512sum --check'. Each line of a list is either in GNU format:
+//
+//	<hex>  <name>    (text mode)
+//	<hex> *<name>    (binary mode)
+//
+// or in BSD tag format:
+//
+//	WHIRLPOOL (<name>) = <hex>
+//
+// Blank lines and lines that start with '#' are skipped. A line that
+// starts with a backslash has an escaped file name (see escapeName).
+// For each file it prints '<name>: OK', '<name>: FAILED' or
+// '<name>: FAILED open or read', then warns about the number of
+// improperly formatted lines, unreadable files and mismatches.
+//
+//	--ignore-missing  skip files that don't exist
+//	--quiet           don't print OK lines
+//	--status          print nothing; only the exit status tells
+//	--strict          fail if any line is improperly formatted
+//	-w, --warn        warn about each improperly formatted line
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// # Contents:
+//
+// # Check Mode
+//   checkList(listName string, opt *options, stdin io.Reader,
+//       stdout, stderr io.Writer) bool
+//   checkLine(line string) (digest whirl.Digest, name string, ok bool)
+//   verifyFile(name string, digest whirl.Digest, stdin io.Reader) (bool, error)
+//   unescapeName(name string) (string, bool)
+//   plural(n int, one, many string) string
+
+// -----------------------------------------------------------------------------
+// # Check Mode
+
+// checkList verifies the checksums listed in the named file, or in
+// 'stdin' if 'listName' is "-". It returns false if the list can't be
+// read, or any check failed, as described at the top of this file.
+func checkList(
+	listName string,
+	opt *options,
+	stdin io.Reader,
+	stdout, stderr io.Writer,
+) bool {
+	r := stdin
+	if listName != "-" {
+		file, err := os.Open(listName)
+		if err != nil {
+			fmt.Fprintf(stderr, "whirlsum: %s: %s\n",
+				listName, errorText(err))
+			return false
+		}
+		defer file.Close()
+		r = file
+	}
+	var (
+		malformed, unreadable, mismatched int
+		properLines, verified             int
+	)
+	report := func(name, result string) {
+		if !opt.status {
+			name, escaped := escapeName(name)
+			if escaped {
+				name = `\` + name
+			}
+			fmt.Fprintf(stdout, "%s: %s\n", name, result)
+		}
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" || trimmed[0] == '#' {
+			continue
+		}
+		digest, name, ok := checkLine(trimmed)
+		if !ok {
+			malformed++
+			if opt.warn && !opt.status {
+				fmt.Fprintf(stderr, "whirlsum: %s: %d: improperly"+
+					" formatted WHIRLPOOL checksum line\n",
+					listName, lineNo)
+			}
+			continue
+		}
+		properLines++
+		match, err := verifyFile(name, digest, stdin)
+		if errors.Is(err, os.ErrNotExist) && opt.ignoreMissing {
+			continue
+		}
+		switch {
+		case err != nil:
+			if !opt.status {
+				fmt.Fprintf(stderr, "whirlsum: %s: %s\n",
+					name, errorText(err))
+			}
+			report(name, "FAILED open or read")
+			unreadable++
+		case !match:
+			report(name, "FAILED")
+			mismatched++
+		default:
+			if !opt.quiet {
+				report(name, "OK")
+			}
+			verified++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "whirlsum: %s: %s\n", listName, errorText(err))
+		return false
+	}
+	if properLines == 0 {
+		if !opt.status {
+			fmt.Fprintf(stderr, "whirlsum: %s: no properly formatted"+
+				" WHIRLPOOL checksum lines found\n", listName)
+		}
+		return false
+	}
+	if !opt.status {
+		if malformed > 0 {
+			fmt.Fprintf(stderr, "whirlsum: WARNING: %d %s\n", malformed,
+				plural(malformed, "line is improperly formatted",
+					"lines are improperly formatted"))
+		}
+		if unreadable > 0 {
+			fmt.Fprintf(stderr, "whirlsum: WARNING: %d %s\n", unreadable,
+				plural(unreadable, "listed file could not be read",
+					"listed files could not be read"))
+		}
+		if mismatched > 0 {
+			fmt.Fprintf(stderr, "whirlsum: WARNING: %d %s\n", mismatched,
+				plural(mismatched, "computed checksum did NOT match",
+					"computed checksums did NOT match"))
+		}
+	}
+	if opt.ignoreMissing && verified+unreadable+mismatched == 0 {
+		if !opt.status {
+			fmt.Fprintf(stderr, "whirlsum: %s: no file was verified\n",
+				listName)
+		}
+		return false
+	}
+	return unreadable == 0 && mismatched == 0 &&
+		(malformed == 0 || !opt.strict)
+} //                                                                   checkList
+
+// checkLine parses a line of a checksum list in GNU or BSD tag
+// format, with leading blanks removed. It returns false if the
+// line is improperly formatted.
+func checkLine(line string) (digest whirl.Digest, name string, ok bool) {
+	escaped := strings.HasPrefix(line, `\`)
+	if escaped {
+		line = line[1:]
+	}
+	var sum string
+	const tag = tagName + " ("
+	if i := strings.LastIndex(line, ") = "); strings.HasPrefix(line, tag) &&
+		i >= len(tag) {
+		name, sum = line[len(tag):i], line[i+len(") = "):]
+	} else {
+		const n = 2 * len(whirl.Digest{})
+		if len(line) < n+3 || line[n] != ' ' ||
+			(line[n+1] != ' ' && line[n+1] != '*') {
+			return digest, "", false
+		}
+		sum, name = line[:n], line[n+2:]
+	}
+	if escaped {
+		if name, ok = unescapeName(name); !ok {
+			return digest, "", false
+		}
+	}
+	digest, err := whirl.ParseDigest(sum)
+	if err != nil || name == "" {
+		return digest, "", false
+	}
+	return digest, name, true
+} //                                                                   checkLine
+
+// verifyFile returns true if the named file, or 'stdin'
+// if 'name' is "-", has the checksum 'digest'.
+func verifyFile(
+	name string,
+	digest whirl.Digest,
+	stdin io.Reader,
+) (bool, error) {
+	r := stdin
+	if name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return false, err
+		}
+		defer file.Close()
+		r = file
+	}
+	return whirl.VerifyReader(r, digest[:])
+} //                                                                  verifyFile
+
+// unescapeName reverses escapeName. It returns false if 'name'
+// has a backslash that is not followed by '\\', 'n' or 'r'.
+func unescapeName(name string) (string, bool) {
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c == '\\' {
+			if i++; i == len(name) {
+				return "", false
+			}
+			switch name[i] {
+			case '\\':
+				c = '\\'
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			default:
+				return "", false
+			}
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String(), true
+} //                                                                unescapeName
+
+// plural returns 'one' if 'n' is 1, otherwise 'many'.
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+} //                                                                      plural
+
+// end
@@ -0,0 +1,203 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package     zr-whirl/[cmd/whirlsum/check_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//  to test all items in check.go use:
+//      go test --run Test_wsum_Check
+
+// go test --run Test_wsum_Check_
+func Test_wsum_Check_(t *testing.T) {
+	dir := t.TempDir()
+	abc := writeFile(t, dir, "abc", "abc")
+	empty := writeFile(t, dir, "empty", "")
+	changed := writeFile(t, dir, "changed", "abd")
+	missing := filepath.Join(dir, "missing")
+	gnu := func(sum, name string) string {
+		return sum + "  " + name + "\n"
+	}
+	bsd := func(sum, name string) string {
+		return "WHIRLPOOL (" + name + ") = " + sum + "\n"
+	}
+	good := writeFile(t, dir, "good.txt",
+		gnu(sumOf("abc"), abc)+
+			strings.ToUpper(sumOf(""))+" *"+empty+"\r\n"+
+			"# a comment\n"+
+			"\n"+
+			bsd(sumOf("abc"), abc))
+	bad := writeFile(t, dir, "bad.txt",
+		gnu(sumOf("abc"), abc)+
+			gnu(sumOf("abc"), changed)+
+			bsd(sumOf(""), missing))
+	malformed := writeFile(t, dir, "malformed.txt",
+		gnu(sumOf("abc"), abc)+
+			sumOf("abc")+" "+abc+"\n"+ // one space
+			gnu(sumOf("abc")[1:], abc)+ // short digest
+			bsd(sumOf("abc")+"0", abc)+ // long digest
+			"WHIRLPOOL ("+abc+" = "+sumOf("abc")+"\n"+
+			`\`+gnu(sumOf("abc"), `a\b`)) // bad escape
+	onlyMissing := writeFile(t, dir, "missing.txt", gnu(sumOf(""), missing))
+	none := writeFile(t, dir, "none.txt", "# nothing\nnot a checksum\n")
+	const (
+		noSuch    = ": no such file or directory\n"
+		unread1   = "whirlsum: WARNING: 1 listed file could not be read\n"
+		mismatch1 = "whirlsum: WARNING: 1 computed checksum did NOT match\n"
+		improper5 = "whirlsum: WARNING: 5 lines are improperly formatted\n"
+	)
+	tests := []struct {
+		args   []string
+		stdin  string
+		status int
+		stdout string
+		stderr string
+	}{
+		{
+			[]string{"-c", good}, "", 0,
+			abc + ": OK\n" + empty + ": OK\n" + abc + ": OK\n", "",
+		},
+		{
+			[]string{"--check", "--quiet", good}, "", 0, "", "",
+		},
+		{
+			[]string{"-c", "-"}, bsd(sumOf("abc"), abc), 0,
+			abc + ": OK\n", "",
+		},
+		{
+			[]string{"-c", bad}, "", 1,
+			abc + ": OK\n" + changed + ": FAILED\n" +
+				missing + ": FAILED open or read\n",
+			"whirlsum: " + missing + noSuch + unread1 + mismatch1,
+		},
+		{
+			[]string{"-c", "--quiet", bad}, "", 1,
+			changed + ": FAILED\n" + missing + ": FAILED open or read\n",
+			"whirlsum: " + missing + noSuch + unread1 + mismatch1,
+		},
+		{[]string{"-c", "--status", bad}, "", 1, "", ""},
+		{[]string{"-c", "--status", good}, "", 0, "", ""},
+		{
+			[]string{"-c", "--ignore-missing", bad}, "", 1,
+			abc + ": OK\n" + changed + ": FAILED\n", mismatch1,
+		},
+		{
+			[]string{"-c", "--ignore-missing", onlyMissing}, "", 1, "",
+			"whirlsum: " + onlyMissing + ": no file was verified\n",
+		},
+		{
+			[]string{"-c", onlyMissing}, "", 1,
+			missing + ": FAILED open or read\n",
+			"whirlsum: " + missing + noSuch + unread1,
+		},
+		{
+			[]string{"-c", malformed}, "", 0, abc + ": OK\n", improper5,
+		},
+		{
+			[]string{"-c", "--strict", malformed}, "", 1,
+			abc + ": OK\n", improper5,
+		},
+		{
+			[]string{"-c", "--strict", "--status", malformed}, "", 1, "", "",
+		},
+		{
+			[]string{"-c", "-w", onlyMissing, malformed}, "", 1,
+			missing + ": FAILED open or read\n" + abc + ": OK\n",
+			"whirlsum: " + missing + noSuch + unread1 +
+				"whirlsum: " + malformed + ": 2: improperly" +
+				" formatted WHIRLPOOL checksum line\n" +
+				"whirlsum: " + malformed + ": 3: improperly" +
+				" formatted WHIRLPOOL checksum line\n" +
+				"whirlsum: " + malformed + ": 4: improperly" +
+				" formatted WHIRLPOOL checksum line\n" +
+				"whirlsum: " + malformed + ": 5: improperly" +
+				" formatted WHIRLPOOL checksum line\n" +
+				"whirlsum: " + malformed + ": 6: improperly" +
+				" formatted WHIRLPOOL checksum line\n" +
+				improper5,
+		},
+		{
+			[]string{"-c", none}, "", 1, "",
+			"whirlsum: " + none + ": no properly formatted" +
+				" WHIRLPOOL checksum lines found\n",
+		},
+		{
+			[]string{"-c", missing}, "", 1, "",
+			"whirlsum: " + missing + noSuch,
+		},
+		// options that don't go with --check, or need it
+		{
+			[]string{"-c", "--tag", good}, "", 1, "",
+			"whirlsum: the --tag option is meaningless" +
+				" when verifying checksums\n",
+		},
+		{
+			[]string{"-c", "-b", good}, "", 1, "",
+			"whirlsum: the --binary and --text options are" +
+				" meaningless when verifying checksums\n",
+		},
+		{
+			[]string{"--strict", abc}, "", 1, "",
+			"whirlsum: the --strict option is" +
+				" meaningful only when verifying checksums\n",
+		},
+	}
+	for i, test := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(test.args, strings.NewReader(test.stdin),
+			&stdout, &stderr)
+		if status != test.status ||
+			stdout.String() != test.stdout ||
+			stderr.String() != test.stderr {
+			t.Errorf("TEST %d %q: returned %d\n stdout %q\n stderr %q\n"+
+				"expected %d\n stdout %q\n stderr %q", i+1, test.args,
+				status, stdout.String(), stderr.String(),
+				test.status, test.stdout, test.stderr)
+		}
+	}
+} //                                                            Test_wsum_Check_
+
+// go test --run Test_wsum_CheckOutput_
+func Test_wsum_CheckOutput_(t *testing.T) {
+	// whatever whirlsum writes, in any format, it can check,
+	// including names that need escaping
+	dir := t.TempDir()
+	files := []string{
+		writeFile(t, dir, "abc", "abc"),
+		writeFile(t, dir, "with space", "message digest"),
+		writeFile(t, dir, "back\\slash", "a"),
+		writeFile(t, dir, "line\nbreak", ""),
+		writeFile(t, dir, "paren) = x", "1234567890"),
+	}
+	for _, format := range [][]string{nil, {"-b"}, {"--tag"}} {
+		var sums, stderr bytes.Buffer
+		if run(append(format, files...), nil, &sums, &stderr) != 0 {
+			t.Fatalf("%q: %s", format, stderr.String())
+		}
+		list := writeFile(t, dir, "sums.txt", sums.String())
+		var stdout bytes.Buffer
+		stderr.Reset()
+		status := run([]string{"-c", list}, nil, &stdout, &stderr)
+		expect := ""
+		for _, name := range files {
+			if escaped := escape(name); escaped != name {
+				name = `\` + escaped
+			}
+			expect += name + ": OK\n"
+		}
+		if status != 0 || stdout.String() != expect || stderr.Len() != 0 {
+			t.Errorf("%q: returned %d\n stdout %q\n stderr %q\n"+
+				"expected stdout %q", format, status,
+				stdout.String(), stderr.String(), expect)
+		}
+	}
+} //                                                      Test_wsum_CheckOutput_
+
+// end
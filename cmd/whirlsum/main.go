@@ -0,0 +1,320 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package           zr-whirl/[cmd/whirlsum/main.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Command whirlsum prints or checks Whirlpool (512-bit) checksums,
+// following the conventions of sha512sum from GNU coreutils:
+//
+//	whirlsum [OPTION]... [FILE]...
+//	whirlsum --check [OPTION]... [FILE]...
+//
+// With no FILE, or when FILE is -, it reads standard input.
+// Each line has the digest in lowercase hex, a space, a mode marker
+// ('*' for binary, ' ' for text) and the file name. Both modes read
+// the file in the same way; the marker is only written to the output.
+//
+//	-b, --binary  mark files as read in binary mode
+//	-t, --text    mark files as read in text mode (the default)
+//	-c, --check   read checksums from the FILEs and check them
+//	    --tag     write BSD-style lines: WHIRLPOOL (FILE) = DIGEST
+//	-z, --zero    end each line with NUL instead of newline,
+//	              and do not escape file names
+//
+// As with getopt, short options can be combined (-bz), options can
+// follow the file names, and "--" marks the end of the options.
+//
+// As in coreutils, a file name that contains a backslash or a line
+// break is escaped, and its line starts with a backslash.
+//
+// With -c or --check, each FILE is a list of checksums in either
+// format, which is verified as described in check.go.
+//
+// The exit status is 0 if every file was hashed or verified, or 1 if
+// any file could not be read, a checksum did not match, or the command
+// line is not valid.
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// # Contents:
+//
+// # Command
+//   main()
+//   run(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+//
+// # Options
+//   options struct
+//   parseOptions(args []string, stderr io.Writer) (*options, error)
+//   permuteArgs(args []string) []string
+//
+// # Hashing
+//   sumFile(name string, stdin io.Reader) ([]byte, error)
+//   formatLine(digest []byte, name string, opt *options) string
+//   escapeName(name string) (string, bool)
+//   errorText(err error) string
+
+// tagName is the algorithm name in BSD-style lines.
+const tagName = "WHIRLPOOL"
+
+// usage is printed for -h, --help and command line errors.
+const usage = `usage: whirlsum [OPTION]... [FILE]...
+Print Whirlpool (512-bit) checksums.
+With no FILE, or when FILE is -, read standard input.
+
+  -b, --binary  read in binary mode
+  -t, --text    read in text mode (default)
+  -c, --check   read checksums from the FILEs and check them
+      --tag     create a BSD-style checksum
+  -z, --zero    end each output line with NUL, not newline,
+                and disable file name escaping
+
+The following options are only useful when verifying checksums:
+      --ignore-missing  don't fail or report status for missing files
+      --quiet           don't print OK for each verified file
+      --status          don't output anything, status code shows success
+      --strict          exit non-zero for improperly formatted lines
+  -w, --warn            warn about improperly formatted lines
+`
+
+// exit status codes
+const (
+	exitOK      = 0
+	exitFailure = 1
+)
+
+// -----------------------------------------------------------------------------
+// # Command
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+} //                                                                        main
+
+// run executes the command with arguments 'args'
+// and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opt, err := parseOptions(args, stderr)
+	if err == flag.ErrHelp {
+		return exitOK
+	}
+	if err != nil {
+		return exitFailure
+	}
+	status := exitOK
+	if opt.check {
+		for _, name := range opt.files {
+			if !checkList(name, opt, stdin, stdout, stderr) {
+				status = exitFailure
+			}
+		}
+		return status
+	}
+	for _, name := range opt.files {
+		digest, err := sumFile(name, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "whirlsum: %s: %s\n", name, errorText(err))
+			status = exitFailure
+			continue
+		}
+		io.WriteString(stdout, formatLine(digest, name, opt))
+	}
+	return status
+} //                                                                         run
+
+// -----------------------------------------------------------------------------
+// # Options
+
+// options holds the parsed command line.
+type options struct {
+	binary        bool
+	check         bool
+	ignoreMissing bool
+	quiet         bool
+	status        bool
+	strict        bool
+	tag           bool
+	text          bool
+	warn          bool
+	zero          bool
+	files         []string
+} //                                                                     options
+
+// parseOptions parses the command line 'args'. It writes usage
+// errors to 'stderr' and returns flag.ErrHelp for -h or --help.
+func parseOptions(args []string, stderr io.Writer) (*options, error) {
+	var opt options
+	fs := flag.NewFlagSet("whirlsum", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		io.WriteString(stderr, usage)
+	}
+	fs.BoolVar(&opt.binary, "binary", false, "read in binary mode")
+	fs.BoolVar(&opt.binary, "b", false, "same as --binary")
+	fs.BoolVar(&opt.check, "check", false, "check checksums in files")
+	fs.BoolVar(&opt.check, "c", false, "same as --check")
+	fs.BoolVar(&opt.ignoreMissing, "ignore-missing", false,
+		"don't fail or report status for missing files")
+	fs.BoolVar(&opt.quiet, "quiet", false, "don't print OK lines")
+	fs.BoolVar(&opt.status, "status", false, "don't output anything")
+	fs.BoolVar(&opt.strict, "strict", false,
+		"exit non-zero for improperly formatted lines")
+	fs.BoolVar(&opt.tag, "tag", false, "create a BSD-style checksum")
+	fs.BoolVar(&opt.text, "text", false, "read in text mode (default)")
+	fs.BoolVar(&opt.text, "t", false, "same as --text")
+	fs.BoolVar(&opt.warn, "warn", false, "warn about improper lines")
+	fs.BoolVar(&opt.warn, "w", false, "same as --warn")
+	fs.BoolVar(&opt.zero, "zero", false, "end each line with NUL")
+	fs.BoolVar(&opt.zero, "z", false, "same as --zero")
+	if err := fs.Parse(permuteArgs(args)); err != nil {
+		return nil, err
+	}
+	var err error
+	switch {
+	case opt.binary && opt.text:
+		err = errors.New("--binary and --text can not be used together")
+	case opt.tag && opt.text:
+		err = errors.New("--tag does not support --text mode")
+	case opt.check && opt.tag:
+		err = errors.New("the --tag option is meaningless" +
+			" when verifying checksums")
+	case opt.check && (opt.binary || opt.text):
+		err = errors.New("the --binary and --text options are" +
+			" meaningless when verifying checksums")
+	case !opt.check:
+		for _, only := range []struct {
+			set  bool
+			name string
+		}{
+			{opt.ignoreMissing, "--ignore-missing"},
+			{opt.quiet, "--quiet"},
+			{opt.status, "--status"},
+			{opt.strict, "--strict"},
+			{opt.warn, "--warn"},
+		} {
+			if only.set {
+				err = errors.New("the " + only.name + " option is" +
+					" meaningful only when verifying checksums")
+				break
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "whirlsum:", err)
+		return nil, err
+	}
+	opt.files = fs.Args()
+	if len(opt.files) == 0 {
+		opt.files = []string{"-"}
+	}
+	return &opt, nil
+} //                                                                parseOptions
+
+// permuteArgs rearranges 'args' the way GNU getopt does, since the
+// flag package stops at the first file name and only knows single
+// flags. It returns the options, then "--", then the file names,
+// splitting combined short options such as -bz into -b -z.
+// Everything after "--" is a file name, and so is "-".
+func permuteArgs(args []string) []string {
+	var flags, files []string
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			files = append(files, args[i+1:]...)
+			return append(append(flags, "--"), files...)
+		case strings.HasPrefix(arg, "--"):
+			flags = append(flags, arg)
+		case len(arg) > 1 && arg[0] == '-':
+			for _, r := range arg[1:] {
+				flags = append(flags, "-"+string(r))
+			}
+		default:
+			files = append(files, arg)
+		}
+	}
+	return append(append(flags, "--"), files...)
+} //                                                                 permuteArgs
+
+// -----------------------------------------------------------------------------
+// # Hashing
+
+// sumFile returns the digest of the named file,
+// or of 'stdin' if 'name' is "-".
+func sumFile(name string, stdin io.Reader) ([]byte, error) {
+	r := stdin
+	if name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+	hash := whirl.New()
+	if _, err := io.Copy(&hash, r); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+} //                                                                     sumFile
+
+// formatLine returns the output line for a file named
+// 'name' with 'digest', including the line terminator.
+func formatLine(digest []byte, name string, opt *options) string {
+	var sb strings.Builder
+	escaped := false
+	if !opt.zero {
+		name, escaped = escapeName(name)
+	}
+	if escaped {
+		sb.WriteByte('\\')
+	}
+	if opt.tag {
+		sb.WriteString(tagName + " (" + name + ") = ")
+		sb.WriteString(hex.EncodeToString(digest))
+	} else {
+		sb.WriteString(hex.EncodeToString(digest))
+		if opt.binary {
+			sb.WriteString(" *")
+		} else {
+			sb.WriteString("  ")
+		}
+		sb.WriteString(name)
+	}
+	if opt.zero {
+		sb.WriteByte(0)
+	} else {
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+} //                                                                  formatLine
+
+// escapeName escapes backslashes and line breaks in a file name,
+// the same way as coreutils. It returns true if it changed 'name'.
+func escapeName(name string) (string, bool) {
+	if !strings.ContainsAny(name, "\\\n\r") {
+		return name, false
+	}
+	r := strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+	return r.Replace(name), true
+} //                                                                  escapeName
+
+// errorText returns the message of 'err' without the operation
+// and file name that os adds, e.g. "no such file or directory".
+func errorText(err error) string {
+	var pe *os.PathError
+	if errors.As(err, &pe) {
+		return pe.Err.Error()
+	}
+	return err.Error()
+} //                                                                   errorText
+
+// end
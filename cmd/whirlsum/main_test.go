@@ -0,0 +1,169 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package      zr-whirl/[cmd/whirlsum/main_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in main.go use:
+//      go test --run Test_wsum_
+
+// go test --run Test_wsum_Run_
+func Test_wsum_Run_(t *testing.T) {
+	dir := t.TempDir()
+	abc := writeFile(t, dir, "abc", "abc")
+	empty := writeFile(t, dir, "empty", "")
+	odd := writeFile(t, dir, "a\\b\nc", "message digest")
+	missing := filepath.Join(dir, "missing")
+	sumABC := sumOf("abc")
+	sumEmpty := sumOf("")
+	sumOdd := sumOf("message digest")
+	tests := []struct {
+		args   []string
+		stdin  string
+		status int
+		stdout string
+		stderr string
+	}{
+		{nil, "abc", 0, sumABC + "  -\n", ""},
+		{[]string{"-"}, "", 0, sumEmpty + "  -\n", ""},
+		{
+			[]string{abc, empty}, "", 0,
+			sumABC + "  " + abc + "\n" + sumEmpty + "  " + empty + "\n", "",
+		},
+		{[]string{"-t", abc}, "", 0, sumABC + "  " + abc + "\n", ""},
+		{[]string{"-b", abc}, "", 0, sumABC + " *" + abc + "\n", ""},
+		{[]string{"--binary", abc}, "", 0, sumABC + " *" + abc + "\n", ""},
+		{
+			[]string{"--tag", abc}, "", 0,
+			"WHIRLPOOL (" + abc + ") = " + sumABC + "\n", "",
+		},
+		{
+			[]string{"--tag", "-b", abc}, "", 0,
+			"WHIRLPOOL (" + abc + ") = " + sumABC + "\n", "",
+		},
+		{
+			[]string{"-z", abc, "-"}, "", 0,
+			sumABC + "  " + abc + "\x00" + sumEmpty + "  -\x00", "",
+		},
+		{
+			[]string{"--zero", "--tag", abc}, "", 0,
+			"WHIRLPOOL (" + abc + ") = " + sumABC + "\x00", "",
+		},
+		// names with a backslash or line break are escaped,
+		// except with --zero
+		{
+			[]string{odd}, "", 0,
+			"\\" + sumOdd + "  " + escape(odd) + "\n", "",
+		},
+		{
+			[]string{"--tag", odd}, "", 0,
+			"\\WHIRLPOOL (" + escape(odd) + ") = " + sumOdd + "\n", "",
+		},
+		{[]string{"-z", odd}, "", 0, sumOdd + "  " + odd + "\x00", ""},
+		// unreadable files are reported, and the rest are hashed
+		{
+			[]string{missing, abc}, "", 1,
+			sumABC + "  " + abc + "\n",
+			"whirlsum: " + missing + ": no such file or directory\n",
+		},
+		{
+			[]string{dir}, "", 1, "",
+			"whirlsum: " + dir + ": is a directory\n",
+		},
+		// options can follow file names and short options can be
+		// combined, as with getopt; "--" ends the options
+		{
+			[]string{abc, "--tag"}, "", 0,
+			"WHIRLPOOL (" + abc + ") = " + sumABC + "\n", "",
+		},
+		{
+			[]string{"-bz", abc}, "", 0,
+			sumABC + " *" + abc + "\x00", "",
+		},
+		{
+			[]string{abc, "-zb", empty}, "", 0,
+			sumABC + " *" + abc + "\x00" + sumEmpty + " *" + empty + "\x00",
+			"",
+		},
+		{
+			[]string{"--", "--tag"}, "", 1, "",
+			"whirlsum: --tag: no such file or directory\n",
+		},
+		// command line errors
+		{
+			[]string{"-b", "-t", abc}, "", 1, "",
+			"whirlsum: --binary and --text can not be used together\n",
+		},
+		{
+			[]string{"--tag", "--text", abc}, "", 1, "",
+			"whirlsum: --tag does not support --text mode\n",
+		},
+	}
+	for i, test := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(test.args, strings.NewReader(test.stdin),
+			&stdout, &stderr)
+		if status != test.status ||
+			stdout.String() != test.stdout ||
+			stderr.String() != test.stderr {
+			t.Errorf("TEST %d %q: returned %d\n stdout %q\n stderr %q\n"+
+				"expected %d\n stdout %q\n stderr %q", i+1, test.args,
+				status, stdout.String(), stderr.String(),
+				test.status, test.stdout, test.stderr)
+		}
+	}
+	var stdout, stderr bytes.Buffer
+	if run([]string{"--nope"}, nil, &stdout, &stderr) != 1 ||
+		!strings.Contains(stderr.String(), "usage: whirlsum") {
+		t.Errorf("unknown flag: stderr %q", stderr.String())
+	}
+	stderr.Reset()
+	if run([]string{abc, "-bx"}, nil, &stdout, &stderr) != 1 ||
+		!strings.Contains(stderr.String(), "-x") {
+		t.Errorf("unknown combined flag: stderr %q", stderr.String())
+	}
+	stderr.Reset()
+	if run([]string{"--help"}, nil, &stdout, &stderr) != 0 ||
+		!strings.Contains(stderr.String(), "--tag") {
+		t.Errorf("--help: stderr %q", stderr.String())
+	}
+} //                                                              Test_wsum_Run_
+
+// escape returns 'name' with its backslash and
+// line break escaped, as whirlsum writes it.
+func escape(name string) string {
+	name = strings.Replace(name, `\`, `\\`, -1)
+	return strings.Replace(name, "\n", `\n`, -1)
+} //                                                                      escape
+
+// sumOf returns the lowercase hex digest of 's'.
+func sumOf(s string) string {
+	digest := whirl.Sum512([]byte(s))
+	return hex.EncodeToString(digest[:])
+} //                                                                       sumOf
+
+// writeFile creates a file named 'name' in 'dir'
+// with 'content' and returns its path.
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, []byte(content), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+} //                                                                   writeFile
+
+// end
@@ -6,13 +6,12 @@
 package whirl
 
 const (
-	cDigestBytes             = 64
-	cDigestBits              = 8 * cDigestBytes // 512
-	cWBlockBytes             = 64
-	cWBlockBits              = 8 * cWBlockBytes // 512
-	cLengthBytes             = 32
-	cLengthBits              = 8 * cLengthBytes // 256
-	cTraceIntermediateValues = false
+	cDigestBytes = 64
+	cDigestBits  = 8 * cDigestBytes // 512
+	cWBlockBytes = 64
+	cWBlockBits  = 8 * cWBlockBytes // 512
+	cLengthBytes = 32
+	cLengthBits  = 8 * cLengthBytes // 256
 
 	// The number of rounds of the internal dedicated block cipher.
 	cRounds = 10
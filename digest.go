@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                      zr-whirl/[digest.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Digest Type and Functions
+//   Digest [cDigestBytes]byte
+//   ParseDigest(s string) (Digest, error)
+//
+// # Digest Methods
+//   (d Digest) Base64() string
+//   (d Digest) Hex() string
+//   (d Digest) MarshalJSON() ([]byte, error)
+//   (d Digest) MarshalText() ([]byte, error)
+//   (d Digest) String() string
+//   (d *Digest) UnmarshalText(text []byte) error
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Digest holds a 512-bit Whirlpool digest, such as the one returned
+// by Sum512(). Its text form is 128 lowercase hexadecimal digits.
+//
+// To store digests with database/sql, wrap them in the types in
+// package whirlsql, which is kept apart since database/sql/driver
+// depends on fmt.
+type Digest [cDigestBytes]byte
+
+// -----------------------------------------------------------------------------
+// # Digest Type and Functions
+
+// ParseDigest parses a digest from 128 hexadecimal
+// digits, which can be in upper or lower case.
+func ParseDigest(s string) (Digest, error) {
+	var ret Digest
+	if len(s) != 2*cDigestBytes {
+		return ret, errors.New("whirl: digest must have " +
+			strconv.Itoa(2*cDigestBytes) + " hex digits, not " +
+			strconv.Itoa(len(s)))
+	}
+	for i := range ret {
+		hi, ok1 := hexDigit(s[2*i])
+		lo, ok2 := hexDigit(s[2*i+1])
+		if !ok1 || !ok2 {
+			return Digest{}, errors.New("whirl: invalid digest:" +
+				" invalid hex digit in " + strconv.Quote(s[2*i:2*i+2]))
+		}
+		ret[i] = hi<<4 | lo
+	}
+	return ret, nil
+} //                                                                 ParseDigest
+
+// -----------------------------------------------------------------------------
+// # Digest Methods
+
+// Base64 returns the digest encoded with standard, padded base64.
+func (d Digest) Base64() string {
+	return base64.StdEncoding.EncodeToString(d[:])
+} //                                                                      Base64
+
+// Hex returns the digest as 128 lowercase hexadecimal digits.
+func (d Digest) Hex() string {
+	return FormatDisplay(d[:], hexDisplay)
+} //                                                                         Hex
+
+// MarshalJSON encodes the digest as a JSON string of hexadecimal digits.
+func (d Digest) MarshalJSON() ([]byte, error) {
+	ret := make([]byte, 0, 2*cDigestBytes+2)
+	ret = append(ret, '"')
+	ret = append(ret, d.Hex()...)
+	ret = append(ret, '"')
+	return ret, nil
+} //                                                                 MarshalJSON
+
+// MarshalText implements encoding.TextMarshaler. It
+// returns the digest as lowercase hexadecimal digits.
+func (d Digest) MarshalText() ([]byte, error) {
+	return []byte(d.Hex()), nil
+} //                                                                 MarshalText
+
+// String returns the digest as 128 lowercase hexadecimal digits.
+func (d Digest) String() string {
+	return d.Hex()
+} //                                                                      String
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts upper or lowercase hexadecimal digits.
+func (d *Digest) UnmarshalText(text []byte) error {
+	ret, err := ParseDigest(strings.TrimSpace(string(text)))
+	if err != nil {
+		return err
+	}
+	*d = ret
+	return nil
+} //                                                               UnmarshalText
+
+// end
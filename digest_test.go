@@ -0,0 +1,97 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                 zr-whirl/[digest_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+//  to test all items in digest.go use:
+//      go test --run Test_dgst_
+
+// the ISO test vector for 'abc'
+const testDigestABC = "4e2448a4c6f486bb16b6562c73b4020b" +
+	"f3043e3a731bce721ae1b303d97e6d4c" +
+	"7181eebdb6c57e277d0e34957114cbd6" +
+	"c797fc9d95d8b582d225292076d4eef5"
+
+// go test --run Test_dgst_ParseDigest_
+func Test_dgst_ParseDigest_(t *testing.T) {
+	expect := Digest(Sum512([]byte("abc")))
+	for _, s := range []string{
+		testDigestABC,
+		strings.ToUpper(testDigestABC),
+	} {
+		got, err := ParseDigest(s)
+		if err != nil || got != expect {
+			t.Errorf("ParseDigest(%q) returned %v, %v", s, got, err)
+		}
+	}
+	for _, s := range []string{
+		"",
+		testDigestABC[:126],
+		testDigestABC + "00",
+		"x" + testDigestABC[1:],
+		" " + testDigestABC[1:],
+	} {
+		got, err := ParseDigest(s)
+		if err == nil || got != (Digest{}) {
+			t.Errorf("ParseDigest(%q) did not fail", s)
+		}
+	}
+} //                                                      Test_dgst_ParseDigest_
+
+// go test --run Test_dgst_Encodings_
+func Test_dgst_Encodings_(t *testing.T) {
+	d := Digest(Sum512([]byte("abc")))
+	if d.String() != testDigestABC || d.Hex() != testDigestABC {
+		t.Errorf("String/Hex returned %s, %s", d.String(), d.Hex())
+	}
+	raw, _ := base64.StdEncoding.DecodeString(d.Base64())
+	if !bytes.Equal(raw, d[:]) {
+		t.Errorf("Base64 returned %s", d.Base64())
+	}
+	text, err := d.MarshalText()
+	if err != nil || string(text) != testDigestABC {
+		t.Errorf("MarshalText returned %s, %v", text, err)
+	}
+	var back Digest
+	if err := back.UnmarshalText(text); err != nil || back != d {
+		t.Errorf("UnmarshalText returned %v, %v", back, err)
+	}
+} //                                                        Test_dgst_Encodings_
+
+// go test --run Test_dgst_JSON_
+func Test_dgst_JSON_(t *testing.T) {
+	type record struct {
+		Sum Digest `json:"sum"`
+	}
+	rec := record{Sum: Sum512([]byte("abc"))}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"sum":"` + testDigestABC + `"}`
+	if string(data) != expect {
+		t.Errorf("json.Marshal returned %s", data)
+	}
+	var back record
+	upper := strings.ToUpper(testDigestABC)
+	err = json.Unmarshal([]byte(`{"sum":"`+upper+`"}`), &back)
+	if err != nil || back != rec {
+		t.Errorf("json.Unmarshal returned %v, %v", back, err)
+	}
+	err = json.Unmarshal([]byte(`{"sum":"abc"}`), &back)
+	if err == nil {
+		t.Errorf("json.Unmarshal accepted a short digest")
+	}
+} //                                                             Test_dgst_JSON_
+
+// end
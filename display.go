@@ -0,0 +1,143 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                     zr-whirl/[display.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Display Format
+//   DisplayFormat struct
+//   ISODisplay DisplayFormat
+//
+// # Public Functions
+//   FormatDisplay(data []byte, format DisplayFormat) string
+//   ParseDisplay(s string) ([]byte, error)
+//   (d Digest) Display(format DisplayFormat) string
+//
+// # Internal Functions
+//   hexDigit(c byte) (n byte, ok bool)
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// DisplayFormat specifies how FormatDisplay() lays out bytes as
+// hexadecimal text. Bytes are written in groups of GroupSize bytes,
+// separated by Separator, with LineSize bytes per line. Each line
+// starts with Indent, and lines are separated by LineBreak.
+// A zero GroupSize or LineSize means no grouping or no line breaks.
+type DisplayFormat struct {
+	GroupSize int
+	LineSize  int
+	Lower     bool // write lowercase instead of uppercase digits
+	Separator string
+	Indent    string
+	LineBreak string
+} //                                                               DisplayFormat
+
+// displaySeparators are the characters that ParseDisplay() ignores.
+const displaySeparators = " \t\r\n\v\f!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// ISODisplay is the layout used for the test vectors in ISO/IEC 10118-3
+// and iso-test-vectors.txt: uppercase digits in groups of 8 bytes,
+// with 32 bytes per line, each line indented by a single space.
+var ISODisplay = DisplayFormat{
+	GroupSize: 8,
+	LineSize:  32,
+	Separator: " ",
+	Indent:    " ",
+	LineBreak: "\n",
+}
+
+// hexDisplay writes plain lowercase hex digits, like
+// hex.EncodeToString(). (encoding/hex depends on fmt.)
+var hexDisplay = DisplayFormat{Lower: true}
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// FormatDisplay returns 'data' as hexadecimal text laid out as
+// specified by 'format'. For example, FormatDisplay(digest[:], ISODisplay)
+// returns a digest in the same layout as the ISO test vectors.
+func FormatDisplay(data []byte, format DisplayFormat) string {
+	digits := "0123456789ABCDEF"
+	if format.Lower {
+		digits = "0123456789abcdef"
+	}
+	var sb strings.Builder
+	sb.Grow(3 * len(data))
+	for i, b := range data {
+		switch {
+		case i == 0:
+			sb.WriteString(format.Indent)
+		case format.LineSize > 0 && i%format.LineSize == 0:
+			sb.WriteString(format.LineBreak)
+			sb.WriteString(format.Indent)
+		case format.GroupSize > 0 && i%format.GroupSize == 0:
+			sb.WriteString(format.Separator)
+		}
+		sb.WriteByte(digits[b>>4])
+		sb.WriteByte(digits[b&0xF])
+	}
+	return sb.String()
+} //                                                               FormatDisplay
+
+// ParseDisplay reads back hexadecimal text written by FormatDisplay()
+// in any layout. Whitespace and ASCII punctuation, which covers the
+// usual separators such as ' ', ':', '-' and '|', are ignored anywhere,
+// and the digits can be in upper or lower case.
+func ParseDisplay(s string) ([]byte, error) {
+	ret := make([]byte, 0, len(s)/2)
+	var hi byte
+	var half bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		n, ok := hexDigit(c)
+		if !ok {
+			if strings.IndexByte(displaySeparators, c) != -1 {
+				continue
+			}
+			return nil, errors.New("whirl: invalid character " +
+				strconv.QuoteRune(rune(c)) + " in hex display")
+		}
+		if half {
+			ret = append(ret, hi<<4|n)
+		} else {
+			hi = n
+		}
+		half = !half
+	}
+	if half {
+		return nil, errors.New("whirl: odd number of digits in hex display")
+	}
+	return ret, nil
+} //                                                                ParseDisplay
+
+// Display returns the digest as hexadecimal text laid out as
+// specified by 'format'. See FormatDisplay().
+func (d Digest) Display(format DisplayFormat) string {
+	return FormatDisplay(d[:], format)
+} //                                                                     Display
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// hexDigit returns the value of the hexadecimal digit 'c',
+// which can be in upper or lower case.
+func hexDigit(c byte) (n byte, ok bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+} //                                                                    hexDigit
+
+// end
@@ -0,0 +1,120 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                zr-whirl/[display_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+//  to test all items in display.go use:
+//      go test --run Test_disp_
+
+// go test --run Test_disp_ISODisplay_
+func Test_disp_ISODisplay_(t *testing.T) {
+	// the digests in iso-test-vectors.txt are on pairs of
+	// lines that start with a space; check the first eight
+	data, err := ioutil.ReadFile("iso-test-vectors.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, " ") && len(line) > 1 {
+			lines = append(lines, line)
+		}
+	}
+	inputs := []string{
+		"",
+		"a",
+		"abc",
+		"message digest",
+		"abcdefghijklmnopqrstuvwxyz",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+		strings.Repeat("1234567890", 8),
+		"abcdbcdecdefdefgefghfghighijhijk",
+	}
+	if len(lines) < 2*len(inputs) {
+		t.Fatalf("found only %d digest lines", len(lines))
+	}
+	for i, input := range inputs {
+		digest := Digest(Sum512([]byte(input)))
+		expect := lines[2*i] + "\n" + lines[2*i+1]
+		got := digest.Display(ISODisplay)
+		if got != expect {
+			t.Errorf("TEST %d FAILED:\nEXPECTED:\n%s\nRETURNED:\n%s",
+				i+1, expect, got)
+		}
+		// must match the layout of the test helper format()
+		if got != strings.TrimLeft(format(digest[:]), "\n") {
+			t.Errorf("TEST %d: layout differs from format()", i+1)
+		}
+		parsed, err := ParseDisplay(expect)
+		if err != nil || !bytes.Equal(parsed, digest[:]) {
+			t.Errorf("TEST %d: ParseDisplay returned %X, %v",
+				i+1, parsed, err)
+		}
+	}
+} //                                                       Test_disp_ISODisplay_
+
+// go test --run Test_disp_FormatDisplay_
+func Test_disp_FormatDisplay_(t *testing.T) {
+	data := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF, 0xFF}
+	tests := []struct {
+		format DisplayFormat
+		expect string
+	}{
+		{DisplayFormat{}, "0123456789ABCDEFFF"},
+		{DisplayFormat{Lower: true}, "0123456789abcdefff"},
+		{
+			DisplayFormat{GroupSize: 2, Separator: ":"},
+			"0123:4567:89AB:CDEF:FF",
+		},
+		{
+			DisplayFormat{GroupSize: 2, LineSize: 4, Separator: " ",
+				LineBreak: "\n", Indent: "  ", Lower: true},
+			"  0123 4567\n  89ab cdef\n  ff",
+		},
+		{
+			DisplayFormat{GroupSize: 1, LineSize: 3, Separator: "-",
+				LineBreak: "|"},
+			"01-23-45|67-89-AB|CD-EF-FF",
+		},
+	}
+	for i, test := range tests {
+		got := FormatDisplay(data, test.format)
+		if got != test.expect {
+			t.Errorf("TEST %d FAILED: returned %q; expected %q",
+				i+1, got, test.expect)
+		}
+		parsed, err := ParseDisplay(got)
+		if err != nil || !bytes.Equal(parsed, data) {
+			t.Errorf("TEST %d: ParseDisplay returned %X, %v",
+				i+1, parsed, err)
+		}
+	}
+	if got := FormatDisplay(nil, ISODisplay); got != "" {
+		t.Errorf("FormatDisplay(nil) returned %q", got)
+	}
+} //                                                    Test_disp_FormatDisplay_
+
+// go test --run Test_disp_ParseDisplay_
+func Test_disp_ParseDisplay_(t *testing.T) {
+	got, err := ParseDisplay("\r\n\tde:AD_be-EF, 00 \n")
+	if err != nil || !bytes.Equal(got, []byte{0xDE, 0xAD, 0xBE, 0xEF, 0}) {
+		t.Errorf("ParseDisplay returned %X, %v", got, err)
+	}
+	for _, s := range []string{"ABC", "0x12", "12 3g", "12\u00A034"} {
+		if _, err := ParseDisplay(s); err == nil {
+			t.Errorf("ParseDisplay(%q) did not fail", s)
+		}
+	}
+} //                                                     Test_disp_ParseDisplay_
+
+// end
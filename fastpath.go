@@ -0,0 +1,166 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                    zr-whirl/[fastpath.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Internal Functions
+//   expandZeroIV() (ret [cRounds][8]uint64)
+//   processFirstBlock(ob *Hash, block *[8]uint64)
+//   roundTransform(out, in, key *[8]uint64)
+//   sumShort(data []byte) [cDigestBytes]byte
+//
+// -----------------------------------------------------------------------------
+//
+// The key schedule of the block cipher starts from the hashing state,
+// which is all zeros before the first block of a message. So for the
+// first block, the round keys K^1..K^10 are always the same. They are
+// computed once, which saves half of the table lookups for that block.
+// Messages shorter than 32 bytes fit in a single padded block, and
+// Sum512() hashes them without going through appendBytes()/finalize().
+
+// firstBlockKeys are the round keys K^1..K^10 derived from
+// the all-zero initial hashing state.
+var firstBlockKeys = expandZeroIV()
+
+// useFirstBlockKeys enables the first-block fast path.
+// Tests and benchmarks turn it off to run the generic path.
+var useFirstBlockKeys = true
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// expandZeroIV returns the round keys K^1..K^10 of the
+// key schedule that starts with K^0 = 0.
+func expandZeroIV() (ret [cRounds][8]uint64) {
+	var K, key [8]uint64
+	for r := 1; r <= cRounds; r++ {
+		key[0] = rc[r]
+		roundTransform(&ret[r-1], &K, &key)
+		K = ret[r-1]
+	}
+	return ret
+} //                                                                expandZeroIV
+
+// processFirstBlock does the same as processBuffer() when the
+// hashing state is all zeros, using the precomputed round keys.
+// 'block' is the buffer already mapped to words.
+func processFirstBlock(ob *Hash, block *[8]uint64) {
+	var (
+		state = *block // K^0 is zero
+		L     [8]uint64
+	)
+	for r := 0; r < cRounds; r++ {
+		roundTransform(&L, &state, &firstBlockKeys[r])
+		state = L
+	}
+	// apply the Miyaguchi-Preneel compression function:
+	for i := 0; i < 8; i++ {
+		ob.hash[i] = state[i] ^ block[i]
+	}
+	wipeWords(&state)
+	wipeWords(&L)
+} //                                                           processFirstBlock
+
+// roundTransform applies the round function of the block cipher
+// to 'in' with round key 'key', and writes the result to 'out'.
+// This is the same transformation that processBuffer() unrolls.
+func roundTransform(out, in, key *[8]uint64) {
+	out[0] = cC0[int(in[0]>>56)] ^
+		cC1[int(in[7]>>48)&0xff] ^
+		cC2[int(in[6]>>40)&0xff] ^
+		cC3[int(in[5]>>32)&0xff] ^
+		cC4[int(in[4]>>24)&0xff] ^
+		cC5[int(in[3]>>16)&0xff] ^
+		cC6[int(in[2]>>8)&0xff] ^
+		cC7[int(in[1])&0xff] ^
+		key[0]
+	out[1] = cC0[int(in[1]>>56)] ^
+		cC1[int(in[0]>>48)&0xff] ^
+		cC2[int(in[7]>>40)&0xff] ^
+		cC3[int(in[6]>>32)&0xff] ^
+		cC4[int(in[5]>>24)&0xff] ^
+		cC5[int(in[4]>>16)&0xff] ^
+		cC6[int(in[3]>>8)&0xff] ^
+		cC7[int(in[2])&0xff] ^
+		key[1]
+	out[2] = cC0[int(in[2]>>56)] ^
+		cC1[int(in[1]>>48)&0xff] ^
+		cC2[int(in[0]>>40)&0xff] ^
+		cC3[int(in[7]>>32)&0xff] ^
+		cC4[int(in[6]>>24)&0xff] ^
+		cC5[int(in[5]>>16)&0xff] ^
+		cC6[int(in[4]>>8)&0xff] ^
+		cC7[int(in[3])&0xff] ^
+		key[2]
+	out[3] = cC0[int(in[3]>>56)] ^
+		cC1[int(in[2]>>48)&0xff] ^
+		cC2[int(in[1]>>40)&0xff] ^
+		cC3[int(in[0]>>32)&0xff] ^
+		cC4[int(in[7]>>24)&0xff] ^
+		cC5[int(in[6]>>16)&0xff] ^
+		cC6[int(in[5]>>8)&0xff] ^
+		cC7[int(in[4])&0xff] ^
+		key[3]
+	out[4] = cC0[int(in[4]>>56)] ^
+		cC1[int(in[3]>>48)&0xff] ^
+		cC2[int(in[2]>>40)&0xff] ^
+		cC3[int(in[1]>>32)&0xff] ^
+		cC4[int(in[0]>>24)&0xff] ^
+		cC5[int(in[7]>>16)&0xff] ^
+		cC6[int(in[6]>>8)&0xff] ^
+		cC7[int(in[5])&0xff] ^
+		key[4]
+	out[5] = cC0[int(in[5]>>56)] ^
+		cC1[int(in[4]>>48)&0xff] ^
+		cC2[int(in[3]>>40)&0xff] ^
+		cC3[int(in[2]>>32)&0xff] ^
+		cC4[int(in[1]>>24)&0xff] ^
+		cC5[int(in[0]>>16)&0xff] ^
+		cC6[int(in[7]>>8)&0xff] ^
+		cC7[int(in[6])&0xff] ^
+		key[5]
+	out[6] = cC0[int(in[6]>>56)] ^
+		cC1[int(in[5]>>48)&0xff] ^
+		cC2[int(in[4]>>40)&0xff] ^
+		cC3[int(in[3]>>32)&0xff] ^
+		cC4[int(in[2]>>24)&0xff] ^
+		cC5[int(in[1]>>16)&0xff] ^
+		cC6[int(in[0]>>8)&0xff] ^
+		cC7[int(in[7])&0xff] ^
+		key[6]
+	out[7] = cC0[int(in[7]>>56)] ^
+		cC1[int(in[6]>>48)&0xff] ^
+		cC2[int(in[5]>>40)&0xff] ^
+		cC3[int(in[4]>>32)&0xff] ^
+		cC4[int(in[3]>>24)&0xff] ^
+		cC5[int(in[2]>>16)&0xff] ^
+		cC6[int(in[1]>>8)&0xff] ^
+		cC7[int(in[0])&0xff] ^
+		key[7]
+} //                                                              roundTransform
+
+// sumShort returns the Whirlpool hash of a message shorter than
+// cLengthBytes (32) bytes. Such a message fits in one block along
+// with its padding: the data, a 0x80 byte, zeros, and the 256-bit
+// message length in bits, of which only the last two bytes are used.
+func sumShort(data []byte) [cDigestBytes]byte {
+	var (
+		hash   Hash // the zero value has Whirlpool's initial state
+		digest [cDigestBytes]byte
+		bits   = 8 * len(data)
+	)
+	copy(hash.buffer[:], data)
+	hash.buffer[len(data)] = 0x80
+	hash.buffer[cWBlockBytes-2] = byte(bits >> 8)
+	hash.buffer[cWBlockBytes-1] = byte(bits)
+	processBuffer(&hash)
+	writeDigest(&hash, digest[:])
+	hash.Zeroize()
+	return digest
+} //                                                                    sumShort
+
+// end
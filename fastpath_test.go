@@ -0,0 +1,131 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[fastpath_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+//  to test all items in fastpath.go use:
+//      go test --run Test_fast_
+//
+//  to compare the fast and generic paths use:
+//      go test --run - --bench Benchmark_fast_
+
+// go test --run Test_fast_ISO_
+func Test_fast_ISO_(t *testing.T) {
+	type vector = struct {
+		input  string
+		expect string
+	}
+	vectors := append([]vector{}, selfTestVectors...)
+	vectors = append(vectors, vector{
+		strings.Repeat("a", 1000000),
+		"0C99005BEB57EFF50A7CF005560DDF5D29057FD86B20BFD62DECA0F1CCEA4AF5" +
+			"1FC15490EDDC47AF32BB2B66C34FF9AD8C6008AD677F77126953B226E4ED8B01",
+	})
+	defer func() { useFirstBlockKeys = true }()
+	for _, fast := range []bool{true, false} {
+		useFirstBlockKeys = fast
+		for i, v := range vectors {
+			digest := Sum512([]byte(v.input))
+			w := New()
+			w.WriteString(v.input)
+			for _, got := range [][]byte{digest[:], w.Sum(nil)} {
+				if !strings.EqualFold(hex.EncodeToString(got), v.expect) {
+					t.Errorf("fast=%v: ISO vector %d FAILED", fast, i+1)
+				}
+			}
+		}
+	}
+} //                                                              Test_fast_ISO_
+
+// go test --run Test_fast_FirstBlockKeys_
+func Test_fast_FirstBlockKeys_(t *testing.T) {
+	// every byte of the zero key selects entry 0 of the tables,
+	// so K^1 is the same word everywhere, with rc[1] added to K^1[0]
+	c := cC0[0] ^ cC1[0] ^ cC2[0] ^ cC3[0] ^
+		cC4[0] ^ cC5[0] ^ cC6[0] ^ cC7[0]
+	expect := [8]uint64{c ^ rc[1], c, c, c, c, c, c, c}
+	if firstBlockKeys[0] != expect {
+		t.Errorf("K^1: got %X; want %X", firstBlockKeys[0], expect)
+	}
+	// the fast path must leave the same state as the generic path
+	block := bytes.Repeat([]byte("0123456789abcdef"), 4)
+	var fast, generic Hash
+	copy(fast.buffer[:], block)
+	copy(generic.buffer[:], block)
+	processBuffer(&fast)
+	useFirstBlockKeys = false
+	processBuffer(&generic)
+	useFirstBlockKeys = true
+	if fast.hash != generic.hash {
+		t.Errorf("first block: got %X; want %X", fast.hash, generic.hash)
+	}
+} //                                                   Test_fast_FirstBlockKeys_
+
+// go test --run Test_fast_SumShort_
+func Test_fast_SumShort_(t *testing.T) {
+	data := make([]byte, 2*cWBlockBytes)
+	for i := range data {
+		data[i] = byte(i*7 + 3)
+	}
+	// lengths around the single-block limit, all compared to the
+	// generic path with the fast path turned off
+	for n := 0; n <= len(data); n++ {
+		got := Sum512(data[:n])
+		useFirstBlockKeys = false
+		expect := Sum512(data[:n])
+		useFirstBlockKeys = true
+		if got != expect {
+			t.Errorf("length %d: fast path returned a wrong digest", n)
+		}
+		if n < cLengthBytes && sumShort(data[:n]) != expect {
+			t.Errorf("length %d: sumShort returned a wrong digest", n)
+		}
+	}
+	// hashes with another initial state don't use precomputed keys
+	w := NewPersonalized("fast path")
+	w.Write(data[:10])
+	useFirstBlockKeys = false
+	w2 := NewPersonalized("fast path")
+	w2.Write(data[:10])
+	expect := w2.Sum(nil)
+	useFirstBlockKeys = true
+	if !bytes.Equal(w.Sum(nil), expect) {
+		t.Errorf("personalized hash returned a wrong digest")
+	}
+} //                                                         Test_fast_SumShort_
+
+// -----------------------------------------------------------------------------
+// # Benchmarks
+
+// go test --run - --bench Benchmark_fast_Sum512_
+func Benchmark_fast_Sum512_(b *testing.B) {
+	for _, size := range []int{16, 31, 64, 1024} {
+		data := make([]byte, size)
+		for _, fast := range []bool{true, false} {
+			name := "generic"
+			if fast {
+				name = "fast"
+			}
+			b.Run(name+"/"+strconv.Itoa(size), func(b *testing.B) {
+				useFirstBlockKeys = fast
+				defer func() { useFirstBlockKeys = true }()
+				b.SetBytes(int64(size))
+				for i := 0; i < b.N; i++ {
+					Sum512(data)
+				}
+			})
+		}
+	}
+} //                                                      Benchmark_fast_Sum512_
+
+// end
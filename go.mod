@@ -5,8 +5,6 @@
 
 module github.com/balacode/zr-whirl
 
-go 1.16
-
-require github.com/balacode/zr v1.1.0
+go 1.17
 
 // end
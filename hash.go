@@ -15,12 +15,31 @@ package whirl
 // # Hash Structure and Methods
 //   Hash struct
 //   New() Hash
+//   (ob *Hash) BitLength() (counter [cLengthBytes]byte)
+//   (ob *Hash) BlockSize() int
+//   (ob *Hash) Finalize(b []byte) []byte
+//   (ob *Hash) Len() (n uint64, ok bool)
+//   (ob *Hash) ReadFrom(r io.Reader) (n int64, err error)
+//   (ob *Hash) Reset()
+//   (ob *Hash) SetStrict(strict bool)
+//   (ob *Hash) Size() int
+//   (ob *Hash) Sum(b []byte) []byte
 //   (ob *Hash) Write(data []byte) (n int, err error)
+//   (ob *Hash) WriteString(s string) (n int, err error)
+//   (ob *Hash) Zeroize()
+//   (ob *Hash) beginWrite() error
 //
 // # Internal Functions
+//   absorbBytes(ob *Hash, n int)
+//   addBitLength(ob *Hash, bits uint64)
 //   appendBytes(source []byte, sourceBits uint64, ob *Hash)
 //   finalize(ob *Hash, result []byte)
+//   finalizeUnchecked(ob *Hash, result []byte)
 //   processBuffer(ob *Hash)
+//   processBuffer64(ob *Hash)
+//   writeDigest(ob *Hash, digest []byte)
+//   wipeBytes(ar *[cWBlockBytes]byte)
+//   wipeWords(ar *[8]uint64)
 //
 // -----------------------------------------------------------------------------
 //
@@ -89,7 +108,8 @@ https://www.cosic.esat.kuleuven.ac.be/nessie/workshop/submissions/whirlpool.zip
 // EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 
 import (
-	"fmt"
+	"errors"
+	"io"
 )
 
 // -----------------------------------------------------------------------------
@@ -97,26 +117,35 @@ import (
 
 // HashOfBytes returns the Whirlpool hash of a byte slice.
 // It also requires a 'salt' argument.
+//
+// The salt and data are simply concatenated, so different pairs
+// can produce the same hash. New code should use SumWith().
 func HashOfBytes(data []byte, salt []byte) []byte {
-	ar := make([]byte, 0, len(salt)+len(data))
-	ar = append(ar, salt[:]...)
-	ar = append(ar, data...)
-	hash := Sum512(ar)
-	return hash[:]
+	hash := New()
+	hash.Write(salt)
+	hash.Write(data)
+	return hash.Sum(nil)
 } //                                                                 HashOfBytes
 
 // HashOfString returns the Whirlpool hash of a string.
 // It also requires a 'salt' argument.
+//
+// Like HashOfBytes(), it simply concatenates the salt and string.
 func HashOfString(s string, salt []byte) []byte {
-	var input []byte
-	input = append(input, salt[:]...)
-	input = append(input, []byte(s)...)
-	hash := Sum512(input)
-	return hash[:]
+	hash := New()
+	hash.Write(salt)
+	hash.WriteString(s)
+	return hash.Sum(nil)
 } //                                                                HashOfString
 
 // Sum512 _ _
 func Sum512(data []byte) [cDigestBytes]byte {
+	if len(data) < cLengthBytes && useFirstBlockKeys {
+		if selfTestErr != nil {
+			panic(selfTestErr)
+		}
+		return sumShort(data)
+	}
 	hash := New()
 	appendBytes(data, uint64(8*len(data)), &hash)
 	var digest [cDigestBytes]byte
@@ -127,6 +156,28 @@ func Sum512(data []byte) [cDigestBytes]byte {
 // -----------------------------------------------------------------------------
 // # Hash Structure and Methods
 
+const (
+	// Size is the size of a Whirlpool-512 digest in bytes.
+	Size = cDigestBytes
+
+	// BlockSize is the block size of Whirlpool in bytes.
+	BlockSize = cWBlockBytes
+)
+
+// ErrFinalized is returned when data is written to a hash
+// after Finalize(), which would otherwise produce garbage digests.
+// Call Reset() to start hashing a new message.
+var ErrFinalized = errors.New("whirl: write to finalized hash")
+
+// hashPhase is the lifecycle state of a Hash.
+type hashPhase uint8
+
+const (
+	phaseFresh     hashPhase = iota // no data written yet
+	phaseAbsorbing                  // data written, not yet finalized
+	phaseFinalized                  // finalized; writes are refused
+)
+
 // Hash _ _
 type Hash struct {
 	// global number of hashed bits (256-bit counter)
@@ -139,6 +190,14 @@ type Hash struct {
 	bufferPos int
 	// the hashing state
 	hash [cDigestBytes / 8]uint64
+	// the initial hashing state, restored by Reset()
+	iv [cDigestBytes / 8]uint64
+	// number of digest bytes returned by Sum(), or 0 for cDigestBytes
+	size int
+	// where the hash is in its lifecycle (fresh, absorbing or finalized)
+	phase hashPhase
+	// panic instead of returning ErrFinalized (see SetStrict)
+	strict bool
 } //                                                                        Hash
 
 // -----------------------------------------------------------------------------
@@ -146,36 +205,246 @@ type Hash struct {
 
 // New initialize the hashing state.
 // (Same as the original implementation's NESSIEinit() function.)
+//
+// Panics if the package was built with the whirl_selftest
+// tag and the init-time SelfTest() has failed.
 func New() Hash {
+	if selfTestErr != nil {
+		panic(selfTestErr)
+	}
 	var ret Hash
 	// it's only necessary to cleanup buffer[bufferPos]
 	if cTraceIntermediateValues {
-		fmt.Printf("Initial hash value:\r\n")
-		for i := 0; i < cDigestBytes/8; i++ {
-			fmt.Printf("    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
-				byte(ret.hash[i]>>56),
-				byte(ret.hash[i]>>48),
-				byte(ret.hash[i]>>40),
-				byte(ret.hash[i]>>32),
-				byte(ret.hash[i]>>24),
-				byte(ret.hash[i]>>16),
-				byte(ret.hash[i]>>8),
-				byte(ret.hash[i]))
-		}
-		fmt.Printf("\r\n")
+		traceInitial(&ret.hash)
 	}
 	return ret
 } //                                                                         New
 
-// Write _ _
+// BitLength returns the number of bits hashed so far, modulo 2^256,
+// as the counter that Whirlpool appends to the message: 32 bytes in
+// big-endian order, so counter[0] is the most significant byte.
+// Unlike Len(), it is exact for messages longer than 2^64 bits,
+// which can be hashed with any number of calls to Write().
+//
+// It replaces BitLen() *big.Int, since math/big depends on fmt,
+// which this package does not use. whirlbig.BitLen() returns
+// the same count as a *big.Int.
+func (ob *Hash) BitLength() (counter [cLengthBytes]byte) {
+	return ob.bitLength
+} //                                                                   BitLength
+
+// BlockSize returns the hash's underlying block size in bytes.
+func (ob *Hash) BlockSize() int {
+	return cWBlockBytes
+} //                                                                   BlockSize
+
+// Finalize appends the digest to 'b' and returns the resulting slice,
+// like Sum(), but finalizes the hash in place instead of working on
+// a copy of its state. After that, Sum() and Finalize() keep returning
+// the same digest, and writes fail with ErrFinalized until Reset().
+func (ob *Hash) Finalize(b []byte) []byte {
+	var digest [cDigestBytes]byte
+	if ob.phase == phaseFinalized {
+		writeDigest(ob, digest[:])
+	} else {
+		finalize(ob, digest[:])
+	}
+	return append(b, digest[:ob.Size()]...)
+} //                                                                    Finalize
+
+// Len returns the number of bytes hashed so far. It returns
+// false if the count doesn't fit in a uint64 (use BitLength() instead),
+// or if the message doesn't end on a whole byte.
+func (ob *Hash) Len() (n uint64, ok bool) {
+	// a count of 2^64-1 bytes needs 67 bits, so the byte just above
+	// the low 64 bits of the counter can hold up to 3 bits
+	const low = cLengthBytes - 8
+	for _, b := range ob.bitLength[:low-1] {
+		if b != 0 {
+			return 0, false
+		}
+	}
+	high := ob.bitLength[low-1]
+	for _, b := range ob.bitLength[low:] {
+		n = n<<8 | uint64(b)
+	}
+	if high > 7 || n&7 != 0 {
+		return 0, false
+	}
+	return n>>3 | uint64(high)<<61, true
+} //                                                                         Len
+
+// ReadFrom implements io.ReaderFrom, so io.Copy() uses it when
+// copying into a hash. It reads from 'r' until io.EOF, directly
+// into the hash's block buffer, without an intermediate buffer.
+//
+// Returns ErrFinalized if the hash has been finalized.
+func (ob *Hash) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = ob.beginWrite(); err != nil {
+		return 0, err
+	}
+	for {
+		var m int
+		if ob.bufferBits&7 == 0 {
+			m, err = r.Read(ob.buffer[ob.bufferPos:])
+			if m > 0 {
+				absorbBytes(ob, m)
+			}
+		} else {
+			// only after appendBytes() was given a partial byte
+			var buf [cWBlockBytes]byte
+			m, err = r.Read(buf[:])
+			appendBytes(buf[:m], 8*uint64(m), ob)
+		}
+		n += int64(m)
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+} //                                                                    ReadFrom
+
+// Reset returns the hash to its initial state, ready
+// for new data even after it has been finalized.
+func (ob *Hash) Reset() {
+	*ob = Hash{hash: ob.iv, iv: ob.iv, size: ob.size, strict: ob.strict}
+} //                                                                       Reset
+
+// Size returns the number of bytes Sum will append.
+func (ob *Hash) Size() int {
+	if ob.size == 0 {
+		return cDigestBytes
+	}
+	return ob.size
+} //                                                                        Size
+
+// SetStrict makes writing to a finalized hash panic with ErrFinalized,
+// instead of returning the error. This is useful in tests, to catch
+// code that writes after taking a digest.
+func (ob *Hash) SetStrict(strict bool) {
+	ob.strict = strict
+} //                                                                   SetStrict
+
+// Sum appends the current digest to 'b' and returns the resulting slice.
+// It does not change the underlying hash state, so more data
+// can be written after calling Sum.
+func (ob *Hash) Sum(b []byte) []byte {
+	var digest [cDigestBytes]byte
+	if ob.phase == phaseFinalized {
+		writeDigest(ob, digest[:])
+		return append(b, digest[:ob.Size()]...)
+	}
+	hash := *ob
+	finalize(&hash, digest[:])
+	hash.Zeroize()
+	return append(b, digest[:ob.Size()]...)
+} //                                                                         Sum
+
+// Write adds data to the hash. Unlike hash.Hash implementations
+// in general, it fails with ErrFinalized after Finalize().
 func (ob *Hash) Write(data []byte) (n int, err error) {
+	if err = ob.beginWrite(); err != nil {
+		return 0, err
+	}
 	appendBytes(data, uint64(8*len(data)), ob)
 	return len(data), nil
 } //                                                                       Write
 
+// WriteString implements io.StringWriter. It hashes 's' by
+// copying it directly into the block buffer, so unlike
+// Write([]byte(s)) it does not allocate.
+//
+// Returns ErrFinalized if the hash has been finalized.
+func (ob *Hash) WriteString(s string) (n int, err error) {
+	if err = ob.beginWrite(); err != nil {
+		return 0, err
+	}
+	n = len(s)
+	for len(s) > 0 {
+		if ob.bufferBits&7 != 0 {
+			// only after appendBytes() was given a partial byte
+			var buf [cWBlockBytes]byte
+			m := copy(buf[:], s)
+			appendBytes(buf[:m], 8*uint64(m), ob)
+			s = s[m:]
+			continue
+		}
+		m := copy(ob.buffer[ob.bufferPos:], s)
+		absorbBytes(ob, m)
+		s = s[m:]
+	}
+	return n, nil
+} //                                                                 WriteString
+
+// Zeroize wipes the data the hash has processed: buffered data, the
+// chaining values and the length counter. Call it when done with
+// a hash that processed passwords or keys. Like Reset(), it keeps
+// the hash's configuration (its initial value, digest size and
+// strict mode), so afterwards the hash is ready for a new message
+// of the same kind.
+//
+//go:noinline
+func (ob *Hash) Zeroize() {
+	iv, size, strict := ob.iv, ob.size, ob.strict
+	*ob = Hash{}
+	ob.hash, ob.iv, ob.size, ob.strict = iv, iv, size, strict
+} //                                                                     Zeroize
+
+// beginWrite moves the hash to the absorbing phase before
+// data is written. It returns ErrFinalized, or panics in strict
+// mode, if the hash has been finalized. Like finalize(), it panics
+// if the init-time self-test has failed, so that a Hash that was not
+// created by New() can't be used either.
+func (ob *Hash) beginWrite() error {
+	if selfTestErr != nil {
+		panic(selfTestErr)
+	}
+	if ob.phase == phaseFinalized {
+		if ob.strict {
+			panic(ErrFinalized)
+		}
+		return ErrFinalized
+	}
+	ob.phase = phaseAbsorbing
+	return nil
+} //                                                                  beginWrite
+
 // -----------------------------------------------------------------------------
 // # Internal Functions
 
+// absorbBytes accounts for 'n' whole bytes that were copied directly
+// into the buffer at bufferPos, when bufferBits is a multiple of 8.
+// The copy must not go past the end of the buffer.
+func absorbBytes(ob *Hash, n int) {
+	addBitLength(ob, 8*uint64(n))
+	ob.bufferPos += n
+	ob.bufferBits += 8 * n
+	if ob.bufferBits == cDigestBits {
+		processBuffer(ob)
+		ob.bufferBits = 0
+		ob.bufferPos = 0
+	}
+	// appendBytes() and finalize() expect the current byte to be clear
+	ob.buffer[ob.bufferPos] = 0
+} //                                                                 absorbBytes
+
+// addBitLength adds 'bits' to the 256-bit count of hashed bits.
+func addBitLength(ob *Hash, bits uint64) {
+	var (
+		bitLength = &ob.bitLength
+		carry     = uint32(0)
+		val       = bits
+	)
+	for i := 31; i >= 0 && (carry != 0 || val != 0); i-- {
+		carry += uint32(bitLength[i]) + (uint32(val) & 0xff)
+		bitLength[i] = byte(carry)
+		carry >>= 8
+		val >>= 8
+	}
+} //                                                                addBitLength
+
 // appendBytes delivers input data to the hashing algorithm.
 //
 // @param    source        plaintext data to hash.
@@ -203,22 +472,12 @@ func appendBytes(source []byte, sourceBits uint64, ob *Hash) {
 		// occupied bits on buffer[bufferPos].
 		bufferRem  = ob.bufferBits & 7
 		buffer     = ob.buffer[:]
-		bitLength  = &ob.bitLength
 		bufferBits = ob.bufferBits
 		bufferPos  = ob.bufferPos
 		b          uint32
 	)
 	// tally the length of the added data:
-	{
-		carry := uint32(0)
-		val := uint64(sourceBits)
-		for i := 31; i >= 0 && (carry != 0 || val != 0); i-- {
-			carry += uint32(bitLength[i]) + (uint32(val) & 0xff)
-			bitLength[i] = byte(carry)
-			carry >>= 8
-			val >>= 8
-		}
-	}
+	addBitLength(ob, sourceBits)
 	// process data in chunks of 8 bits
 	// (a more efficient approach would be to take whole-word chunks):
 	for sourceBits > 8 {
@@ -278,9 +537,20 @@ func appendBytes(source []byte, sourceBits uint64, ob *Hash) {
 	ob.bufferPos = bufferPos
 } //                                                                 appendBytes
 
-// finalize gets the hash value from the hashing state.
-// This method uses the invariant: bufferBits < cDigestBits
+// finalize gets the hash value from the hashing state. Every digest
+// except those of Sum512()'s short-message path goes through it, so
+// it panics if the init-time self-test has failed (see SelfTest()).
 func finalize(ob *Hash, result []byte) {
+	if selfTestErr != nil {
+		panic(selfTestErr)
+	}
+	finalizeUnchecked(ob, result)
+} //                                                                    finalize
+
+// finalizeUnchecked does the work of finalize(), without checking
+// the self-test, which uses it to run after it has failed.
+// This method uses the invariant: bufferBits < cDigestBits
+func finalizeUnchecked(ob *Hash, result []byte) {
 	var (
 		buffer     = ob.buffer[:]
 		bufferBits = ob.bufferBits
@@ -314,23 +584,26 @@ func finalize(ob *Hash, result []byte) {
 	processBuffer(ob)
 	//
 	// return the completed message digest:
-	for i, b := 0, 0; i < cDigestBytes/8; i++ {
-		digest[b+0] = byte(ob.hash[i] >> 56)
-		digest[b+1] = byte(ob.hash[i] >> 48)
-		digest[b+2] = byte(ob.hash[i] >> 40)
-		digest[b+3] = byte(ob.hash[i] >> 32)
-		digest[b+4] = byte(ob.hash[i] >> 24)
-		digest[b+5] = byte(ob.hash[i] >> 16)
-		digest[b+6] = byte(ob.hash[i] >> 8)
-		digest[b+7] = byte(ob.hash[i])
-		b += 8
-	}
+	writeDigest(ob, digest)
+	// clear the padded final block, which may hold secret data:
+	wipeBytes(&ob.buffer)
 	ob.bufferBits = bufferBits
 	ob.bufferPos = bufferPos
-} //                                                                    finalize
+	ob.phase = phaseFinalized
+} //                                                           finalizeUnchecked
 
 // The core Whirlpool transform.
 func processBuffer(ob *Hash) {
+	// 32-bit platforms use split tables (see block32.go):
+	if is32BitArch && !cTraceIntermediateValues {
+		processBuffer32(ob)
+		return
+	}
+	processBuffer64(ob)
+} //                                                               processBuffer
+
+// processBuffer64 is the core Whirlpool transform on 64-bit words.
+func processBuffer64(ob *Hash) {
 	var (
 		K      [8]uint64 // the round key
 		block  [8]uint64 // mu(buffer)
@@ -339,16 +612,7 @@ func processBuffer(ob *Hash) {
 		buffer = ob.buffer[:]
 	)
 	if cTraceIntermediateValues {
-		fmt.Printf("The 8x8 matrix Z' derived from the" +
-			" data-string is as follows.\r\n")
-		for i, b := 0, 0; i < cWBlockBytes/8; i++ {
-			fmt.Printf("    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
-				buffer[b+0], buffer[b+1], buffer[b+2], buffer[b+3],
-				buffer[b+4], buffer[b+5], buffer[b+6], buffer[b+7])
-			b += 8
-		}
-		fmt.Printf("\r\n")
-		buffer = ob.buffer[:]
+		traceBlock(&ob.buffer)
 	}
 	// map the buffer to a block:
 	for i, b := 0, 0; i < 8; i++ {
@@ -362,40 +626,20 @@ func processBuffer(ob *Hash) {
 			(uint64(buffer[b+7]) & 0xff)
 		b += 8
 	}
+	// the round keys of the first block are precomputed:
+	if ob.hash == [cDigestBytes / 8]uint64{} && useFirstBlockKeys &&
+		!cTraceIntermediateValues {
+		processFirstBlock(ob, &block)
+		wipeWords(&block)
+		return
+	}
 	// compute and apply K^0 to the cipher state:
 	for i := 0; i < 8; i++ {
 		K[i] = ob.hash[i]
 		state[i] = block[i] ^ K[i]
 	}
 	if cTraceIntermediateValues {
-		fmt.Printf("The K_0 matrix (from the initialization value IV)" +
-			" and X'' matrix are as follows.\r\n")
-		for i := 0; i < cDigestBytes/8; i++ {
-			fmt.Printf(
-				"    %02X %02X %02X %02X %02X %02X %02X %02X    "+
-					"    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
-				byte(K[i]>>56),
-				byte(K[i]>>48),
-				byte(K[i]>>40),
-				byte(K[i]>>32),
-				byte(K[i]>>24),
-				byte(K[i]>>16),
-				byte(K[i]>>8),
-				byte(K[i]),
-				byte(state[i]>>56),
-				byte(state[i]>>48),
-				byte(state[i]>>40),
-				byte(state[i]>>32),
-				byte(state[i]>>24),
-				byte(state[i]>>16),
-				byte(state[i]>>8),
-				byte(state[i]),
-			)
-		}
-		fmt.Printf("\r\n" +
-			"The following are (hexadecimal representations of) the" +
-			" successive values of the variables" +
-			" K_i for i = 1 to 10 and W'.\r\n\r\n")
+		traceKey0(&K, &state)
 	}
 	// iterate over all rounds:
 	for r := 1; r <= cRounds; r++ {
@@ -555,30 +799,7 @@ func processBuffer(ob *Hash) {
 		state[6] = L[6]
 		state[7] = L[7]
 		if cTraceIntermediateValues {
-			fmt.Printf("i = %d:\r\n", r)
-			for i := 0; i < cDigestBytes/8; i++ {
-				fmt.Printf(
-					"    %02X %02X %02X %02X %02X %02X %02X %02X        "+
-						"%02X %02X %02X %02X %02X %02X %02X %02X\r\n",
-					byte(K[i]>>56),
-					byte(K[i]>>48),
-					byte(K[i]>>40),
-					byte(K[i]>>32),
-					byte(K[i]>>24),
-					byte(K[i]>>16),
-					byte(K[i]>>8),
-					byte(K[i]),
-					byte(state[i]>>56),
-					byte(state[i]>>48),
-					byte(state[i]>>40),
-					byte(state[i]>>32),
-					byte(state[i]>>24),
-					byte(state[i]>>16),
-					byte(state[i]>>8),
-					byte(state[i]),
-				)
-			}
-			fmt.Printf("\r\n")
+			traceRound(r, &K, &state)
 		}
 	}
 	// apply the Miyaguchi-Preneel compression function:
@@ -591,21 +812,44 @@ func processBuffer(ob *Hash) {
 	ob.hash[6] ^= state[6] ^ block[6]
 	ob.hash[7] ^= state[7] ^ block[7]
 	if cTraceIntermediateValues {
-		fmt.Printf("The value of Y' output from the" +
-			" round-function is as follows.\r\n")
-		for i := 0; i < cDigestBytes/8; i++ {
-			fmt.Printf("    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
-				byte(ob.hash[i]>>56),
-				byte(ob.hash[i]>>48),
-				byte(ob.hash[i]>>40),
-				byte(ob.hash[i]>>32),
-				byte(ob.hash[i]>>24),
-				byte(ob.hash[i]>>16),
-				byte(ob.hash[i]>>8),
-				byte(ob.hash[i]))
-		}
-		fmt.Printf("\r\n")
+		traceOutput(&ob.hash)
 	}
-} //                                                               processBuffer
+	// clear the round keys and cipher state from the stack:
+	wipeWords(&K)
+	wipeWords(&block)
+	wipeWords(&state)
+	wipeWords(&L)
+} //                                                             processBuffer64
+
+// writeDigest writes the hashing state to 'digest' in big-endian order.
+func writeDigest(ob *Hash, digest []byte) {
+	for i, b := 0, 0; i < cDigestBytes/8; i++ {
+		digest[b+0] = byte(ob.hash[i] >> 56)
+		digest[b+1] = byte(ob.hash[i] >> 48)
+		digest[b+2] = byte(ob.hash[i] >> 40)
+		digest[b+3] = byte(ob.hash[i] >> 32)
+		digest[b+4] = byte(ob.hash[i] >> 24)
+		digest[b+5] = byte(ob.hash[i] >> 16)
+		digest[b+6] = byte(ob.hash[i] >> 8)
+		digest[b+7] = byte(ob.hash[i])
+		b += 8
+	}
+} //                                                                 writeDigest
+
+// wipeBytes clears a block buffer. It is not inlined, so
+// the compiler can not remove the stores as dead code.
+//
+//go:noinline
+func wipeBytes(ar *[cWBlockBytes]byte) {
+	*ar = [cWBlockBytes]byte{}
+} //                                                                   wipeBytes
+
+// wipeWords clears an array of 64-bit words. It is not inlined,
+// so the compiler can not remove the stores as dead code.
+//
+//go:noinline
+func wipeWords(ar *[8]uint64) {
+	*ar = [8]uint64{}
+} //                                                                   wipeWords
 
 // end
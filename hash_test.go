@@ -8,10 +8,11 @@ package whirl
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"math/big"
 	"strings"
 	"testing"
-
-	"github.com/balacode/zr"
+	"testing/iotest"
 )
 
 //  to test all items in hash.go use:
@@ -340,6 +341,364 @@ func (ob *Hash) printStruct(title string) {
 	fmt.Println()
 } //                                                                 printStruct
 
+// go test --run Test_hash_BitLength_
+func Test_hash_BitLength_(t *testing.T) {
+	w := New()
+	if bitLen(&w).Sign() != 0 {
+		t.Errorf("New hash has a nonzero length")
+	}
+	if n, ok := w.Len(); n != 0 || !ok {
+		t.Errorf("Len: got %d, %v; want 0, true", n, ok)
+	}
+	w.Write(make([]byte, 1000))
+	if got := bitLen(&w); got.Cmp(big.NewInt(8000)) != 0 {
+		t.Errorf("BitLength: got %v; want 8000", got)
+	}
+	if n, ok := w.Len(); n != 1000 || !ok {
+		t.Errorf("Len: got %d, %v; want 1000, true", n, ok)
+	}
+	appendBytes([]byte{0x05}, 3, &w)
+	if got := bitLen(&w); got.Cmp(big.NewInt(8003)) != 0 {
+		t.Errorf("BitLength: got %v; want 8003", got)
+	}
+	if _, ok := w.Len(); ok {
+		t.Errorf("Len is ok for a partial byte")
+	}
+	w.Reset()
+	if bitLen(&w).Sign() != 0 {
+		t.Errorf("Reset did not clear the length")
+	}
+} //                                                        Test_hash_BitLength_
+
+// bitLen returns the bit counter of 'hash' as a number.
+func bitLen(hash *Hash) *big.Int {
+	counter := hash.BitLength()
+	return new(big.Int).SetBytes(counter[:])
+} //                                                                      bitLen
+
+// go test --run Test_hash_Carry_
+func Test_hash_Carry_(t *testing.T) {
+	pow2 := func(n uint) *big.Int {
+		return new(big.Int).Lsh(big.NewInt(1), n)
+	}
+	sub := func(a *big.Int, b int64) *big.Int {
+		return new(big.Int).Sub(a, big.NewInt(b))
+	}
+	tests := []struct {
+		seed   *big.Int
+		bits   uint64
+		expect *big.Int
+	}{
+		{sub(pow2(64), 8), 8, pow2(64)},
+		{sub(pow2(64), 1), 1, pow2(64)},
+		{sub(pow2(64), 8), 8000, new(big.Int).Add(pow2(64), big.NewInt(7992))},
+		{sub(pow2(128), 8), 8, pow2(128)},
+		{sub(pow2(128), 1), 8, new(big.Int).Add(pow2(128), big.NewInt(7))},
+		{sub(pow2(192), 8), 16, new(big.Int).Add(pow2(192), big.NewInt(8))},
+		// the counter wraps around at 2^256
+		{sub(pow2(256), 8), 16, big.NewInt(8)},
+	}
+	for i, test := range tests {
+		w := New()
+		seedBitLength(&w, test.seed)
+		appendBytes(make([]byte, (test.bits+7)/8), test.bits, &w)
+		if got := bitLen(&w); got.Cmp(test.expect) != 0 {
+			t.Errorf("TEST %d: BitLength: got %v; want %v",
+				i+1, got, test.expect)
+		}
+	}
+	// Len works up to 2^64-1 bytes, which is 2^67-8 bits
+	w := New()
+	seedBitLength(&w, sub(pow2(67), 16))
+	w.Write([]byte{0})
+	if n, ok := w.Len(); n != 1<<64-1 || !ok {
+		t.Errorf("Len: got %d, %v; want 2^64-1, true", n, ok)
+	}
+	w.Write([]byte{0})
+	if n, ok := w.Len(); n != 0 || ok {
+		t.Errorf("Len: got %d, %v; want 0, false", n, ok)
+	}
+	// the seeded length goes into the padding, and changes the digest
+	a, b := New(), New()
+	seedBitLength(&b, pow2(64))
+	a.Write([]byte("abc"))
+	b.Write([]byte("abc"))
+	if bytes.Equal(a.Sum(nil), b.Sum(nil)) {
+		t.Errorf("seeded length did not change the digest")
+	}
+} //                                                            Test_hash_Carry_
+
+// seedBitLength is a test hook that sets the 256-bit counter of
+// hashed bits, so that lengths beyond 2^64 bits can be tested
+// without hashing that much data.
+func seedBitLength(ob *Hash, n *big.Int) {
+	ob.bitLength = [cLengthBytes]byte{}
+	n.FillBytes(ob.bitLength[:])
+} //                                                               seedBitLength
+
+// go test --run Test_hash_ReadFrom_
+func Test_hash_ReadFrom_(t *testing.T) {
+	data := []byte(strings.Repeat("0123456789", 100))
+	for _, size := range []int{0, 1, 63, 64, 65, 127, 128, 129, 1000} {
+		expect := Sum512(data[:size])
+		readers := []io.Reader{
+			bytes.NewReader(data[:size]),
+			iotest.OneByteReader(bytes.NewReader(data[:size])),
+			iotest.HalfReader(bytes.NewReader(data[:size])),
+			iotest.DataErrReader(bytes.NewReader(data[:size])),
+		}
+		for i, r := range readers {
+			// start at a different position in the buffer each time
+			prefix := data[:i*21]
+			w := New()
+			w.Write(prefix)
+			n, err := w.ReadFrom(r)
+			if n != int64(size) || err != nil {
+				t.Errorf("size %d, reader %d: ReadFrom returned %d, %v",
+					size, i+1, n, err)
+			}
+			expect = Sum512(append(append([]byte{}, prefix...),
+				data[:size]...))
+			if got := w.Sum(nil); !bytes.Equal(got, expect[:]) {
+				t.Errorf("size %d, reader %d: wrong digest", size, i+1)
+			}
+		}
+	}
+	// io.Copy must use ReadFrom, and errors must be passed on
+	w := New()
+	failure := fmt.Errorf("read failed")
+	n, err := io.Copy(&w, io.MultiReader(
+		iotest.HalfReader(bytes.NewReader(data[:100])),
+		iotest.ErrReader(failure),
+	))
+	if n != 100 || err != failure {
+		t.Errorf("io.Copy returned %d, %v", n, err)
+	}
+	// after a partial byte, ReadFrom must fall back to appendBytes
+	// (appendBytes takes the bits of a partial byte from its low end)
+	var digest, expect [cDigestBytes]byte
+	w = New()
+	appendBytes([]byte{0x05}, 3, &w)
+	w.ReadFrom(bytes.NewReader(data[:200]))
+	finalize(&w, digest[:])
+	w = New()
+	appendBytes(append([]byte{0x05}, data[:200]...), 3+8*200, &w)
+	finalize(&w, expect[:])
+	if digest != expect {
+		t.Errorf("ReadFrom after a partial byte returned a wrong digest")
+	}
+} //                                                         Test_hash_ReadFrom_
+
+// go test --run Test_hash_WriteString_
+func Test_hash_WriteString_(t *testing.T) {
+	s := strings.Repeat("abcdefghijklmnopqrstuvwxyz", 20)
+	for _, size := range []int{0, 1, 31, 32, 63, 64, 65, 200, 520} {
+		w := New()
+		w.Write([]byte(s[:size%7]))
+		n, err := w.WriteString(s[:size])
+		if n != size || err != nil {
+			t.Errorf("WriteString returned %d, %v", n, err)
+		}
+		expect := Sum512([]byte(s[:size%7] + s[:size]))
+		if got := w.Sum(nil); !bytes.Equal(got, expect[:]) {
+			t.Errorf("size %d: wrong digest", size)
+		}
+	}
+	// after a partial byte, WriteString must fall back to appendBytes
+	var digest, expect [cDigestBytes]byte
+	w := New()
+	appendBytes([]byte{0x05}, 3, &w)
+	w.WriteString(s)
+	finalize(&w, digest[:])
+	w = New()
+	appendBytes(append([]byte{0x05}, s...), 3+8*uint64(len(s)), &w)
+	finalize(&w, expect[:])
+	if digest != expect {
+		t.Errorf("WriteString after a partial byte returned a wrong digest")
+	}
+} //                                                      Test_hash_WriteString_
+
+// go test --run Test_hash_Allocs_
+func Test_hash_Allocs_(t *testing.T) {
+	s := strings.Repeat("x", 1000)
+	w := New()
+	r := strings.NewReader(s)
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"WriteString", func() { w.WriteString(s) }},
+		{"ReadFrom", func() {
+			r.Reset(s)
+			w.ReadFrom(r)
+		}},
+	}
+	for _, test := range tests {
+		if n := testing.AllocsPerRun(100, test.fn); n != 0 {
+			t.Errorf("%s made %v allocations per call", test.name, n)
+		}
+	}
+} //                                                           Test_hash_Allocs_
+
+// go test --run Test_hash_Zeroize_
+func Test_hash_Zeroize_(t *testing.T) {
+	w := NewPersonalized("keys")
+	w.SetStrict(true)
+	w.Write([]byte(strings.Repeat("secret key material ", 10)))
+	fresh := NewPersonalized("keys")
+	fresh.SetStrict(true)
+	if w == fresh {
+		t.Fatal("hash state is unexpectedly empty")
+	}
+	w.Zeroize()
+	if w.bitLength != [cLengthBytes]byte{} ||
+		w.buffer != [cWBlockBytes]byte{} ||
+		w.bufferBits != 0 || w.bufferPos != 0 {
+		t.Errorf("Zeroize left data in the hash: %+v", w)
+	}
+	// the configuration is kept, so the wiped hash
+	// is the same as a new one made the same way
+	if w != fresh {
+		t.Errorf("Zeroize did not keep the configuration: %+v", w)
+	}
+	w.Write([]byte("abc"))
+	fresh.Write([]byte("abc"))
+	if !bytes.Equal(w.Sum(nil), fresh.Sum(nil)) {
+		t.Errorf("wiped hash returned a wrong digest")
+	}
+	w256 := New256()
+	w256.Write([]byte("abc"))
+	w256.Zeroize()
+	w256.Write([]byte("abc"))
+	expect := Sum256([]byte("abc"))
+	if got := w256.Sum(nil); !bytes.Equal(got, expect[:]) {
+		t.Errorf("wiped New256() hash returned %X; expected %X", got, expect)
+	}
+} //                                                          Test_hash_Zeroize_
+
+// go test --run Test_hash_FinalizeWipes_
+func Test_hash_FinalizeWipes_(t *testing.T) {
+	var digest [cDigestBytes]byte
+	for _, size := range []int{0, 10, 31, 32, 63, 64, 100} {
+		w := New()
+		w.Write(bytes.Repeat([]byte{0xAA}, size))
+		finalize(&w, digest[:])
+		if w.buffer != [cWBlockBytes]byte{} {
+			t.Errorf("size %d: finalize left data in the buffer: %X",
+				size, w.buffer)
+		}
+	}
+	// Sum works on a copy and must leave the receiver intact
+	w := New()
+	w.Write([]byte("partial block"))
+	before := w
+	w.Sum(nil)
+	if w != before {
+		t.Errorf("Sum changed the hash state")
+	}
+	words := [8]uint64{1, 2, 3, 4, 5, 6, 7, 8}
+	wipeWords(&words)
+	block := [cWBlockBytes]byte{1, 2, 3}
+	wipeBytes(&block)
+	if words != [8]uint64{} || block != [cWBlockBytes]byte{} {
+		t.Errorf("wipeWords or wipeBytes did not clear the array")
+	}
+} //                                                    Test_hash_FinalizeWipes_
+
+// go test --run Test_hash_Lifecycle_
+func Test_hash_Lifecycle_(t *testing.T) {
+	expect := Sum512([]byte("abc"))
+	//
+	// fresh -> absorbing -> finalized
+	w := New()
+	if w.phase != phaseFresh {
+		t.Errorf("New hash is not fresh")
+	}
+	w.Write([]byte("ab"))
+	if w.phase != phaseAbsorbing {
+		t.Errorf("hash is not absorbing after Write")
+	}
+	// Sum leaves the hash absorbing, so writing can continue
+	w.Sum(nil)
+	if _, err := w.WriteString("c"); err != nil {
+		t.Errorf("WriteString after Sum failed: %v", err)
+	}
+	got := w.Finalize(nil)
+	if !bytes.Equal(got, expect[:]) || w.phase != phaseFinalized {
+		t.Errorf("Finalize returned a wrong digest")
+	}
+	// a finalized hash keeps returning the same digest
+	if !bytes.Equal(w.Sum(nil), expect[:]) ||
+		!bytes.Equal(w.Finalize([]byte{1}), append([]byte{1}, expect[:]...)) {
+		t.Errorf("finalized hash returned a different digest")
+	}
+	// writes to a finalized hash fail and leave it unchanged
+	before := w
+	if n, err := w.Write([]byte("x")); n != 0 || err != ErrFinalized {
+		t.Errorf("Write: got %d, %v; want 0, ErrFinalized", n, err)
+	}
+	if n, err := w.WriteString("x"); n != 0 || err != ErrFinalized {
+		t.Errorf("WriteString: got %d, %v; want 0, ErrFinalized", n, err)
+	}
+	n, err := w.ReadFrom(strings.NewReader("x"))
+	if n != 0 || err != ErrFinalized {
+		t.Errorf("ReadFrom: got %d, %v; want 0, ErrFinalized", n, err)
+	}
+	if w != before {
+		t.Errorf("failed writes changed the hash state")
+	}
+	// finalized -> fresh
+	w.Reset()
+	if w.phase != phaseFresh {
+		t.Errorf("Reset hash is not fresh")
+	}
+	w.Write([]byte("abc"))
+	if !bytes.Equal(w.Finalize(nil), expect[:]) {
+		t.Errorf("Reset hash returned a wrong digest")
+	}
+	w.Zeroize()
+	if _, err := w.Write([]byte("abc")); err != nil {
+		t.Errorf("Write after Zeroize failed: %v", err)
+	}
+	// truncated hashes keep their size across Finalize and Reset
+	w = New256()
+	w.Finalize(nil)
+	w.Reset()
+	if got := w.Finalize(nil); len(got) != Size256 {
+		t.Errorf("New256 after Reset returned %d bytes", len(got))
+	}
+} //                                                        Test_hash_Lifecycle_
+
+// go test --run Test_hash_Strict_
+func Test_hash_Strict_(t *testing.T) {
+	mustPanic := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != ErrFinalized {
+				t.Errorf("%s: got panic %v; want ErrFinalized", name, r)
+			}
+		}()
+		fn()
+	}
+	w := New()
+	w.SetStrict(true)
+	w.Write([]byte("abc"))
+	w.Finalize(nil)
+	mustPanic("Write", func() { w.Write([]byte("x")) })
+	mustPanic("WriteString", func() { w.WriteString("x") })
+	mustPanic("ReadFrom", func() { w.ReadFrom(strings.NewReader("x")) })
+	//
+	// strict mode survives Reset, and does not affect valid writes
+	w.Reset()
+	w.Write([]byte("abc"))
+	w.Finalize(nil)
+	mustPanic("Write after Reset", func() { w.Write(nil) })
+	w.SetStrict(false)
+	if _, err := w.Write(nil); err != ErrFinalized {
+		t.Errorf("non-strict Write: got %v; want ErrFinalized", err)
+	}
+} //                                                           Test_hash_Strict_
+
 // go test --run Test_hash_Whirlpool_
 func Test_hash_Whirlpool_(t *testing.T) {
 	// testAPI()
@@ -0,0 +1,38 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package         zr-whirl/[internal/nessie/doc.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package nessie wraps C implementations of Whirlpool derived from the
+// reference code of the NESSIE submission, which package whirl was
+// translated from. It is only used by whirl's differential tests
+// (nessie_test.go), and needs cgo: without it, this package is empty
+// and those tests are not built.
+//
+// The php directory holds the verbatim upstream code. The original
+// NESSIE archive can not be downloaded, so the files are PHP's copy of
+// the reference code, vendored byte for byte from:
+//
+//	module:  github.com/php/php-src
+//	version: v0.0.0-20250923175726-7791bc69c5d6 (commit 7791bc69c5d6)
+//	zip:     https://proxy.golang.org/github.com/php/php-src/@v/
+//	         v0.0.0-20250923175726-7791bc69c5d6.zip
+//	sha256:  62f33edc72892d65a19afa4b8beabd979accb6495a2107ae54fd4f3d50b74a8b
+//
+//	php/hash_whirlpool.c             ext/hash/hash_whirlpool.c
+//	php/php_hash_whirlpool.h         ext/hash/php_hash_whirlpool.h
+//	php/php_hash_whirlpool_tables.h  ext/hash/php_hash_whirlpool_tables.h
+//	php/LICENSE                      LICENSE (the PHP License 3.01)
+//
+// The sha256 of each file is in php/SHA256SUMS, which nessie_test.go
+// checks. The files are not edited. The PHP engine is replaced by
+// php_hash.h, which declares the few names that hash_whirlpool.c uses,
+// and upstream.c compiles it from this directory. Upstream wraps it.
+//
+// The PHP code only hashes whole bytes, so Hash wraps Whirlpool.c,
+// a transcription of the reference code for messages of any length
+// in bits. It uses the vendored tables, and its header lists the
+// edits made to the original.
+package nessie
+
+// end
@@ -0,0 +1,173 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package      zr-whirl/[internal/nessie/nessie.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build cgo
+// +build cgo
+
+package nessie
+
+// #cgo CFLAGS: -I${SRCDIR}
+// #include "nessie.h"
+// #include "upstream.h"
+import "C"
+
+import (
+	"unsafe"
+)
+
+// # Contents:
+//
+// # Hash Type and Methods
+//   Hash struct
+//   New() *Hash
+//   (ob *Hash) Add(source []byte, sourceBits uint64)
+//   (ob *Hash) Finalize() (digest [64]byte)
+//   (ob *Hash) State() State
+//
+// # Upstream Type and Methods
+//   Upstream struct
+//   NewUpstream() *Upstream
+//   (ob *Upstream) Finalize() (digest [64]byte)
+//   (ob *Upstream) State() State
+//   (ob *Upstream) Write(p []byte)
+//
+// # State Type
+//   State struct
+//
+// # Tables
+//   RoundConstant(r int) uint64
+//   Table(t, i int) uint64
+
+// -----------------------------------------------------------------------------
+// # Hash Type and Methods
+
+// Hash holds the C NESSIEstruct. It contains no Go pointers,
+// so a pointer to it can be passed to the C functions.
+type Hash struct {
+	s C.struct_NESSIEstruct
+} //                                                                        Hash
+
+// New returns a hash initialized by NESSIEinit().
+func New() *Hash {
+	ret := new(Hash)
+	C.NESSIEinit(&ret.s)
+	return ret
+} //                                                                         New
+
+// Add calls NESSIEadd() to hash 'sourceBits' bits of 'source'.
+// 'source' must hold at least (sourceBits+7)/8 bytes.
+func (ob *Hash) Add(source []byte, sourceBits uint64) {
+	if uint64(len(source)) < (sourceBits+7)/8 {
+		panic("nessie: source is shorter than sourceBits")
+	}
+	var p *C.uchar
+	if len(source) > 0 {
+		p = (*C.uchar)(unsafe.Pointer(&source[0]))
+	}
+	C.NESSIEadd(p, C.ulong(sourceBits), &ob.s)
+} //                                                                         Add
+
+// Finalize calls NESSIEfinalize() and returns the digest.
+func (ob *Hash) Finalize() (digest [64]byte) {
+	C.NESSIEfinalize(&ob.s, (*C.uchar)(unsafe.Pointer(&digest[0])))
+	return digest
+} //                                                                    Finalize
+
+// State returns a copy of the fields of the NESSIEstruct.
+func (ob *Hash) State() State {
+	var ret State
+	for i := range ret.BitLength {
+		ret.BitLength[i] = byte(ob.s.bitLength[i])
+	}
+	for i := range ret.Buffer {
+		ret.Buffer[i] = byte(ob.s.buffer[i])
+	}
+	ret.BufferBits = int(ob.s.bufferBits)
+	ret.BufferPos = int(ob.s.bufferPos)
+	for i := range ret.Hash {
+		ret.Hash[i] = uint64(ob.s.hash[i])
+	}
+	return ret
+} //                                                                       State
+
+// -----------------------------------------------------------------------------
+// # Upstream Type and Methods
+
+// Upstream holds the context of the vendored PHP code (see doc.go).
+// Like Hash, it contains no Go pointers.
+type Upstream struct {
+	ctx C.PHP_WHIRLPOOL_CTX
+} //                                                                    Upstream
+
+// NewUpstream returns a context initialized by PHP_WHIRLPOOLInit().
+func NewUpstream() *Upstream {
+	ret := new(Upstream)
+	C.PHP_WHIRLPOOLInit(&ret.ctx, nil)
+	return ret
+} //                                                                 NewUpstream
+
+// Finalize calls PHP_WHIRLPOOLFinal() and returns the digest.
+// PHP_WHIRLPOOLFinal() clears the context afterwards.
+func (ob *Upstream) Finalize() (digest [64]byte) {
+	C.PHP_WHIRLPOOLFinal((*C.uchar)(unsafe.Pointer(&digest[0])), &ob.ctx)
+	return digest
+} //                                                                    Finalize
+
+// State returns a copy of the fields of the context, which
+// are the same as those of a NESSIEstruct under other names.
+func (ob *Upstream) State() State {
+	var ret State
+	for i := range ret.BitLength {
+		ret.BitLength[i] = byte(ob.ctx.bitlength[i])
+	}
+	for i := range ret.Buffer {
+		ret.Buffer[i] = byte(ob.ctx.buffer.data[i])
+	}
+	ret.BufferBits = int(ob.ctx.buffer.bits)
+	ret.BufferPos = int(ob.ctx.buffer.pos)
+	for i := range ret.Hash {
+		ret.Hash[i] = uint64(ob.ctx.state[i])
+	}
+	return ret
+} //                                                                       State
+
+// Write calls PHP_WHIRLPOOLUpdate() to hash the bytes of 'p'.
+func (ob *Upstream) Write(p []byte) {
+	var ptr *C.uchar
+	if len(p) > 0 {
+		ptr = (*C.uchar)(unsafe.Pointer(&p[0]))
+	}
+	C.PHP_WHIRLPOOLUpdate(&ob.ctx, ptr, C.size_t(len(p)))
+} //                                                                       Write
+
+// -----------------------------------------------------------------------------
+// # State Type
+
+// State holds the fields of a NESSIEstruct, which match
+// the unexported fields of the same names in whirl.Hash.
+type State struct {
+	BitLength  [32]byte
+	Buffer     [64]byte
+	BufferBits int
+	BufferPos  int
+	Hash       [8]uint64
+} //                                                                       State
+
+// -----------------------------------------------------------------------------
+// # Tables
+
+// RoundConstant returns rc[r] of the vendored
+// php/php_hash_whirlpool_tables.h, which Whirlpool.c also uses.
+func RoundConstant(r int) uint64 {
+	return uint64(C.UPSTREAMroundConstant(C.int(r)))
+} //                                                               RoundConstant
+
+// Table returns entry 'i' of table C0..C7 selected by 't',
+// from the vendored php/php_hash_whirlpool_tables.h.
+func Table(t, i int) uint64 {
+	return uint64(C.UPSTREAMtable(C.int(t), C.int(i)))
+} //                                                                       Table
+
+// end
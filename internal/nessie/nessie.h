@@ -0,0 +1,53 @@
+/*
+ * ZR Library - Whirlpool Hash Package   zr-whirl/[internal/nessie/nessie.h]
+ *
+ * Declarations for the Whirlpool reference implementation in Whirlpool.c,
+ * following the NESSIE API (nessie.h of the NESSIE submission).
+ *
+ * The original nessie.h selects the integer types with <limits.h> checks;
+ * this version uses <stdint.h>, and defines the L64 macro that the
+ * vendored php/php_hash_whirlpool_tables.h uses for its constants.
+ *
+ * This file is in the public domain, like the original.
+ */
+
+#ifndef PORTABLE_C__
+#define PORTABLE_C__
+
+#include <stdint.h>
+
+typedef uint8_t  u8;
+typedef uint32_t u32;
+typedef uint64_t u64;
+
+#define LL(v) (v##ULL)
+#define L64 INT64_C
+
+/*
+ * Whirlpool-specific definitions.
+ */
+
+#define DIGESTBYTES 64
+#define DIGESTBITS  (8*DIGESTBYTES) /* 512 */
+
+#define WBLOCKBYTES 64
+#define WBLOCKBITS  (8*WBLOCKBYTES) /* 512 */
+
+#define LENGTHBYTES 32
+#define LENGTHBITS  (8*LENGTHBYTES) /* 256 */
+
+typedef struct NESSIEstruct {
+	u8  bitLength[LENGTHBYTES]; /* global number of hashed bits (256-bit counter) */
+	u8  buffer[WBLOCKBYTES];    /* buffer of data to hash */
+	int bufferBits;             /* current number of bits on the buffer */
+	int bufferPos;              /* current (possibly incomplete) byte slot on the buffer */
+	u64 hash[DIGESTBYTES/8];    /* the hashing state */
+} NESSIEstruct;
+
+void NESSIEinit(struct NESSIEstruct * const structpointer);
+void NESSIEadd(const unsigned char * const source, unsigned long sourceBits,
+               struct NESSIEstruct * const structpointer);
+void NESSIEfinalize(struct NESSIEstruct * const structpointer,
+                    unsigned char * const result);
+
+#endif /* PORTABLE_C__ */
@@ -0,0 +1,73 @@
+/*
+ * ZR Library - Whirlpool Hash Package  zr-whirl/[internal/nessie/php_hash.h]
+ *
+ * A stand-in for PHP's ext/hash/php_hash.h, with just enough of it to
+ * compile the vendored php/hash_whirlpool.c outside PHP. The real header
+ * pulls in the whole Zend engine; this one only declares the names that
+ * hash_whirlpool.c uses. The serialization hooks in php_hash_ops are
+ * stubs that always fail, since the tests never call them.
+ *
+ * This file is not part of PHP, and is in the public domain.
+ */
+
+#ifndef PHP_HASH_H
+#define PHP_HASH_H
+
+#include <stddef.h>
+#include <stdint.h>
+#include <string.h>
+
+#define PHP_HASH_API
+#define ZEND_ATTRIBUTE_UNUSED
+#define ZEND_SECURE_ZERO(var, size) memset((var), 0, (size))
+
+#define SUCCESS 0
+#define FAILURE -1
+
+#define PHP_HASH_SERIALIZE_MAGIC_SPEC 2
+
+#define L64 INT64_C
+
+typedef long zend_long;
+typedef struct HashTable HashTable;
+typedef struct zval zval;
+
+typedef struct _php_hashcontext_object {
+	void *context;
+} php_hashcontext_object;
+
+typedef void (*php_hash_init_func_t)(void *context, HashTable *args);
+typedef void (*php_hash_update_func_t)(void *context, const unsigned char *buf, size_t count);
+typedef void (*php_hash_final_func_t)(unsigned char *digest, void *context);
+typedef int  (*php_hash_copy_func_t)(const void *ops, void *orig_context, void *dest_context);
+typedef int  (*php_hash_serialize_func_t)(const php_hashcontext_object *hash, zend_long *magic, zval *zv);
+typedef int  (*php_hash_unserialize_func_t)(php_hashcontext_object *hash, zend_long magic, const zval *zv);
+
+typedef struct _php_hash_ops {
+	const char *algo;
+	php_hash_init_func_t hash_init;
+	php_hash_update_func_t hash_update;
+	php_hash_final_func_t hash_final;
+	php_hash_copy_func_t hash_copy;
+	php_hash_serialize_func_t hash_serialize;
+	php_hash_unserialize_func_t hash_unserialize;
+	const char *serialize_spec;
+	size_t digest_size;
+	size_t block_size;
+	size_t context_size;
+	unsigned is_crypto: 1;
+} php_hash_ops;
+
+static inline int php_hash_copy(const void *ops, void *orig_context, void *dest_context) {
+	return FAILURE;
+}
+
+static inline int php_hash_serialize(const php_hashcontext_object *hash, zend_long *magic, zval *zv) {
+	return FAILURE;
+}
+
+static inline int php_hash_unserialize_spec(php_hashcontext_object *hash, const zval *zv, const char *spec) {
+	return FAILURE;
+}
+
+#endif /* PHP_HASH_H */
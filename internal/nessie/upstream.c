@@ -0,0 +1,23 @@
+/*
+ * ZR Library - Whirlpool Hash Package  zr-whirl/[internal/nessie/upstream.c]
+ *
+ * Compiles the vendored php/hash_whirlpool.c, which cgo would not build
+ * from a subdirectory, and gives Go access to its tables. The vendored
+ * file is included unchanged: php_hash.h in this directory stands in
+ * for PHP's header of the same name.
+ *
+ * This file is in the public domain.
+ */
+
+#include "upstream.h"
+
+#include "php/hash_whirlpool.c"
+
+uint64_t UPSTREAMroundConstant(int r) {
+	return rc[r];
+}
+
+uint64_t UPSTREAMtable(int t, int i) {
+	static const uint64_t *tables[8] = {C0, C1, C2, C3, C4, C5, C6, C7};
+	return tables[t][i];
+}
@@ -0,0 +1,23 @@
+/*
+ * ZR Library - Whirlpool Hash Package  zr-whirl/[internal/nessie/upstream.h]
+ *
+ * Declarations for upstream.c, which compiles the vendored PHP code in
+ * the php directory. PHP_WHIRLPOOLInit(), PHP_WHIRLPOOLUpdate() and
+ * PHP_WHIRLPOOLFinal() are declared by php/php_hash_whirlpool.h.
+ *
+ * This file is in the public domain.
+ */
+
+#ifndef UPSTREAM_H
+#define UPSTREAM_H
+
+#include "php_hash.h"
+#include "php/php_hash_whirlpool.h"
+
+/* returns rc[r] of php/php_hash_whirlpool_tables.h */
+uint64_t UPSTREAMroundConstant(int r);
+
+/* returns entry i of table C0..C7 selected by t */
+uint64_t UPSTREAMtable(int t, int i);
+
+#endif /* UPSTREAM_H */
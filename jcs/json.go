@@ -0,0 +1,350 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                    zr-whirl/[jcs/json.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package jcs hashes JSON documents with Whirlpool in their canonical
+// form, as specified by the JSON Canonicalization Scheme (RFC 8785).
+// It is a separate package because encoding/json imports fmt.
+package jcs
+
+// # Contents:
+//
+// # Public Functions
+//   CanonicalJSON(r io.Reader) ([]byte, error)
+//   SumJSON(r io.Reader) ([whirl.Size]byte, error)
+//
+// # Internal Types and Functions
+//   jsonMember struct
+//   checkIJSON(data []byte) error
+//   formatJSONNumber(f float64) string
+//   lessUTF16(a, b string) bool
+//   readJSON(r io.Reader) (interface{}, error)
+//   readJSONValue(dec *json.Decoder, tok json.Token, depth int)
+//       (interface{}, error)
+//   writeJSON(w io.Writer, v interface{})
+//   writeJSONString(w io.Writer, s string)
+//
+// -----------------------------------------------------------------------------
+//
+// JSON documents are canonicalized as specified by the JSON
+// Canonicalization Scheme (JCS), RFC 8785:
+// - Insignificant whitespace is removed.
+// - Object members are sorted by their names, comparing
+//   the names as arrays of UTF-16 code units.
+// - Numbers are written the way ECMAScript's Number.toString()
+//   writes them, e.g. 4.50 becomes 4.5 and 1E30 becomes 1e+30.
+// - Strings use the shortest escape sequences: \" \\ \b \f \n \r \t,
+//   and \u00xx for other control characters. All other characters,
+//   including non-ASCII ones, are written as UTF-8.
+//
+// Input must be I-JSON (RFC 7493), as RFC 8785 requires: documents
+// that are not valid UTF-8, or whose strings have unpaired \uD800 to
+// \uDFFF escapes, are rejected instead of having those characters
+// replaced by U+FFFD. So are documents with duplicate member names,
+// and numbers that can't be represented as IEEE 754 doubles.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// jsonMaxDepth limits the nesting of arrays and objects,
+// so that hostile input can not exhaust the stack.
+const jsonMaxDepth = 10000
+
+// jsonMember is a single name/value pair of a JSON object.
+type jsonMember struct {
+	name  string
+	value interface{}
+} //                                                                  jsonMember
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// CanonicalJSON reads a JSON document and returns its canonical
+// form as specified by RFC 8785. This is the exact byte sequence
+// that SumJSON() hashes, which is useful for debugging.
+func CanonicalJSON(r io.Reader) ([]byte, error) {
+	v, err := readJSON(r)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writeJSON(&buf, v)
+	return buf.Bytes(), nil
+} //                                                               CanonicalJSON
+
+// SumJSON reads a JSON document and returns the Whirlpool hash
+// of its canonical form (RFC 8785), so that documents which differ
+// only in formatting, member order or number notation have the
+// same digest. The canonical form is written directly into
+// the hashing state.
+func SumJSON(r io.Reader) ([whirl.Size]byte, error) {
+	var digest [whirl.Size]byte
+	v, err := readJSON(r)
+	if err != nil {
+		return digest, err
+	}
+	hash := whirl.New()
+	writeJSON(&hash, v)
+	hash.Finalize(digest[:0])
+	return digest, nil
+} //                                                                     SumJSON
+
+// -----------------------------------------------------------------------------
+// # Internal Types and Functions
+
+// checkIJSON returns an error if 'data' is not valid UTF-8, or
+// if a string in it has an escaped UTF-16 surrogate that is not
+// part of a pair. Other syntax errors are left to the decoder.
+func checkIJSON(data []byte) error {
+	if !utf8.Valid(data) {
+		return errors.New("jcs: JSON is not valid UTF-8")
+	}
+	// escape returns the code unit of a \uXXXX escape at data[i:],
+	// or -1 if there isn't one
+	escape := func(i int) rune {
+		if i+6 > len(data) || data[i] != '\\' || data[i+1] != 'u' {
+			return -1
+		}
+		n, err := strconv.ParseUint(string(data[i+2:i+6]), 16, 16)
+		if err != nil {
+			return -1
+		}
+		return rune(n)
+	}
+	inString := false
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == '"':
+			inString = !inString
+		case c == '\\' && inString:
+			r := escape(i)
+			switch {
+			case r >= 0xD800 && r <= 0xDBFF:
+				if r2 := escape(i + 6); r2 < 0xDC00 || r2 > 0xDFFF {
+					return errors.New("jcs: unpaired UTF-16" +
+						" surrogate in JSON string")
+				}
+				i += 11 // skip both escapes
+			case r >= 0xDC00 && r <= 0xDFFF:
+				return errors.New("jcs: unpaired UTF-16" +
+					" surrogate in JSON string")
+			default:
+				i++ // skip the escaped character
+			}
+		}
+	}
+	return nil
+} //                                                                  checkIJSON
+
+// formatJSONNumber formats a number the same
+// way as ECMAScript's Number.prototype.toString().
+func formatJSONNumber(f float64) string {
+	if f == 0 {
+		return "0" // also for -0
+	}
+	abs := math.Abs(f)
+	if abs < 1e-6 || abs >= 1e21 {
+		// Go writes 1e-07 where ECMAScript writes 1e-7
+		s := strconv.FormatFloat(f, 'e', -1, 64)
+		n := len(s)
+		if n >= 4 && s[n-4] == 'e' && s[n-3] == '-' && s[n-2] == '0' {
+			s = s[:n-2] + s[n-1:]
+		}
+		return s
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+} //                                                            formatJSONNumber
+
+// lessUTF16 compares two strings as arrays of UTF-16 code units.
+func lessUTF16(a, b string) bool {
+	ua := utf16.Encode([]rune(a))
+	ub := utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+} //                                                                   lessUTF16
+
+// readJSON parses a single JSON document into a tree of values:
+// nil, bool, float64, string, []interface{} and []jsonMember.
+// The members of each object are sorted.
+func readJSON(r io.Reader) (interface{}, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIJSON(data); err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	ret, err := readJSONValue(dec, tok, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("jcs: unexpected data after JSON value")
+	}
+	return ret, nil
+} //                                                                    readJSON
+
+// readJSONValue parses the value that starts with token 'tok'.
+func readJSONValue(
+	dec *json.Decoder,
+	tok json.Token,
+	depth int,
+) (interface{}, error) {
+	if depth > jsonMaxDepth {
+		return nil, errors.New("jcs: JSON nesting too deep")
+	}
+	switch tok := tok.(type) {
+	case json.Number:
+		f, err := strconv.ParseFloat(string(tok), 64)
+		if err != nil {
+			return nil, errors.New("jcs: invalid JSON number " +
+				string(tok))
+		}
+		return f, nil
+	case json.Delim:
+		switch tok {
+		case '[':
+			ret := []interface{}{}
+			for dec.More() {
+				elem, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := readJSONValue(dec, elem, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, v)
+			}
+			_, err := dec.Token() // ']'
+			return ret, err
+		case '{':
+			ret := []jsonMember{}
+			names := map[string]bool{}
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				name, _ := tok.(string)
+				if names[name] {
+					return nil, errors.New(
+						"jcs: duplicate JSON member name " +
+							strconv.Quote(name))
+				}
+				names[name] = true
+				tok, err = dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := readJSONValue(dec, tok, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, jsonMember{name, v})
+			}
+			sort.Slice(ret, func(i, j int) bool {
+				return lessUTF16(ret[i].name, ret[j].name)
+			})
+			_, err := dec.Token() // '}'
+			return ret, err
+		}
+	case nil, bool, string:
+		return tok, nil
+	}
+	return nil, errors.New("jcs: unexpected JSON token")
+} //                                                               readJSONValue
+
+// writeJSON writes the canonical form of a value returned by readJSON().
+func writeJSON(w io.Writer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		io.WriteString(w, "null")
+	case bool:
+		io.WriteString(w, strconv.FormatBool(v))
+	case float64:
+		io.WriteString(w, formatJSONNumber(v))
+	case string:
+		writeJSONString(w, v)
+	case []interface{}:
+		io.WriteString(w, "[")
+		for i, elem := range v {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			writeJSON(w, elem)
+		}
+		io.WriteString(w, "]")
+	case []jsonMember:
+		io.WriteString(w, "{")
+		for i, member := range v {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			writeJSONString(w, member.name)
+			io.WriteString(w, ":")
+			writeJSON(w, member.value)
+		}
+		io.WriteString(w, "}")
+	}
+} //                                                                   writeJSON
+
+// writeJSONString writes a quoted string with minimal escaping.
+func writeJSONString(w io.Writer, s string) {
+	const hex = "0123456789abcdef"
+	var buf bytes.Buffer
+	buf.Grow(len(s) + 2)
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[c>>4])
+				buf.WriteByte(hex[c&0xF])
+				continue
+			}
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+	w.Write(buf.Bytes())
+} //                                                             writeJSONString
+
+// end
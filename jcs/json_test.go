@@ -0,0 +1,189 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[jcs/json_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package jcs
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in json.go use:
+//      go test --run Test_json_
+
+// go test --run Test_json_formatJSONNumber_
+func Test_json_formatJSONNumber_(t *testing.T) {
+	// test vectors from RFC 8785, Appendix B
+	tests := []struct {
+		bits   uint64
+		expect string
+	}{
+		{0x0000000000000000, "0"},
+		{0x8000000000000000, "0"},
+		{0x0000000000000001, "5e-324"},
+		{0x8000000000000001, "-5e-324"},
+		{0x7fefffffffffffff, "1.7976931348623157e+308"},
+		{0xffefffffffffffff, "-1.7976931348623157e+308"},
+		{0x4340000000000000, "9007199254740992"},
+		{0xc340000000000000, "-9007199254740992"},
+		{0x4430000000000000, "295147905179352830000"},
+		{0x44b52d02c7e14af5, "9.999999999999997e+22"},
+		{0x44b52d02c7e14af6, "1e+23"},
+		{0x44b52d02c7e14af7, "1.0000000000000001e+23"},
+		{0x444b1ae4d6e2ef4e, "999999999999999700000"},
+		{0x444b1ae4d6e2ef4f, "999999999999999900000"},
+		{0x444b1ae4d6e2ef50, "1e+21"},
+		{0x3eb0c6f7a0b5ed8c, "9.999999999999997e-7"},
+		{0x3eb0c6f7a0b5ed8d, "0.000001"},
+		{0x41b3de4355555553, "333333333.3333332"},
+		{0x41b3de4355555554, "333333333.33333325"},
+		{0x41b3de4355555555, "333333333.3333333"},
+		{0x41b3de4355555556, "333333333.3333334"},
+		{0x41b3de4355555557, "333333333.33333343"},
+		{0xbecbf647612f3696, "-0.0000033333333333333333"},
+		{0x43143ff3c1cb0959, "1424953923781206.2"},
+	}
+	for i, test := range tests {
+		got := formatJSONNumber(math.Float64frombits(test.bits))
+		if got != test.expect {
+			t.Errorf("TEST %d FAILED: %016x formatted as %s; expected %s",
+				i+1, test.bits, got, test.expect)
+		}
+	}
+} //                                                 Test_json_formatJSONNumber_
+
+// go test --run Test_json_CanonicalJSON_
+func Test_json_CanonicalJSON_(t *testing.T) {
+	tests := []struct {
+		note   string
+		input  string
+		expect string
+	}{
+		{
+			note: "RFC 8785, section 3.2.2",
+			input: `{
+  "numbers": [333333333.33333329, 1E30, 4.50,
+              2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`,
+			expect: `{"literals":[null,true,false],` +
+				`"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],` +
+				`"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		{
+			note: "RFC 8785, section 3.2.3",
+			input: `{
+  "\u20ac": "Euro Sign",
+  "\r": "Carriage Return",
+  "\ufb33": "Hebrew Letter Dalet With Dagesh",
+  "1": "One",
+  "\ud83d\ude00": "Emoji: Grinning Face",
+  "\u0080": "Control",
+  "\u00f6": "Latin Small Letter O With Diaeresis"
+}`,
+			expect: "{\"\\r\":\"Carriage Return\"," +
+				"\"1\":\"One\"," +
+				"\"\u0080\":\"Control\"," +
+				"\"\u00f6\":\"Latin Small Letter O With Diaeresis\"," +
+				"\"\u20ac\":\"Euro Sign\"," +
+				"\"\U0001F600\":\"Emoji: Grinning Face\"," +
+				"\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+		},
+		{
+			note:   "nested values and whitespace",
+			input:  " [ {\"b\" : [ ], \"a\" : { } } , \"\\t\\u0001\" , -0 ] ",
+			expect: `[{"a":{},"b":[]},"\t\u0001",0]`,
+		},
+		{
+			note:   "scalar document",
+			input:  "1.0E2",
+			expect: "100",
+		},
+	}
+	for i, test := range tests {
+		got, err := CanonicalJSON(strings.NewReader(test.input))
+		if err != nil {
+			t.Errorf("TEST %d (%s): %v", i+1, test.note, err)
+			continue
+		}
+		if string(got) != test.expect {
+			t.Errorf("TEST %d (%s) FAILED:\nEXPECTED: %s\nRETURNED: %s",
+				i+1, test.note, test.expect, got)
+		}
+		digest, err := SumJSON(strings.NewReader(test.input))
+		if err != nil || digest != whirl.Sum512([]byte(test.expect)) {
+			t.Errorf("TEST %d (%s): SumJSON does not match the"+
+				" digest of the canonical form", i+1, test.note)
+		}
+	}
+} //                                                    Test_json_CanonicalJSON_
+
+// go test --run Test_json_SumJSON_
+func Test_json_SumJSON_(t *testing.T) {
+	a, errA := SumJSON(strings.NewReader(`{"x": 1.50, "y": [true]}`))
+	b, errB := SumJSON(strings.NewReader("{\n\t\"y\":[true],\"x\":15e-1}"))
+	if errA != nil || errB != nil || a != b {
+		t.Errorf("equivalent documents produced different digests")
+	}
+	for _, input := range []string{
+		``,
+		`{"a":1,"a":2}`,
+		`{"a":1} {}`,
+		`[1,]`,
+		`{"a"}`,
+		`1e400`,
+		strings.Repeat("[", jsonMaxDepth+2) +
+			strings.Repeat("]", jsonMaxDepth+2),
+	} {
+		if _, err := SumJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("SumJSON(%.20q) did not return an error", input)
+		}
+	}
+} //                                                          Test_json_SumJSON_
+
+// go test --run Test_json_IJSON_
+func Test_json_IJSON_(t *testing.T) {
+	// invalid UTF-8 and lone surrogates must not turn into U+FFFD
+	for _, input := range []string{
+		`{"a":"\ud800"}`,
+		`{"a":"\udc00"}`,
+		`{"a":"\ud800\u0041"}`,
+		`{"a":"\ud800x"}`,
+		`{"a":"\ude00\ud83d"}`,
+		`{"\ud800":1}`,
+		`["\ud83d"]`,
+		"{\"a\":\"\xff\"}",
+		"{\"a\":\"\xed\xa0\x80\"}", // UTF-8 encoded surrogate
+		"[1, \"\xc3\"]",
+	} {
+		if _, err := CanonicalJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("CanonicalJSON(%q) did not return an error", input)
+		}
+		if _, err := SumJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("SumJSON(%q) did not return an error", input)
+		}
+	}
+	for _, test := range []struct {
+		input  string
+		expect string
+	}{
+		{`{"a":"\ufffd"}`, "{\"a\":\"\ufffd\"}"},
+		{`"\uD83D\uDE00"`, "\"\U0001F600\""},
+		{`"\\ud800"`, `"\\ud800"`}, // an escaped backslash
+		{`"\\\u0041"`, `"\\A"`},
+	} {
+		got, err := CanonicalJSON(strings.NewReader(test.input))
+		if err != nil || string(got) != test.expect {
+			t.Errorf("CanonicalJSON(%q) returned %q, %v; expected %q",
+				test.input, got, err, test.expect)
+		}
+	}
+} //                                                            Test_json_IJSON_
+
+// end
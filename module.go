@@ -4,6 +4,23 @@
 // -----------------------------------------------------------------------------
 
 // Package whirl implements the Whirlpool hashing algorithm.
+//
+// The package has no third-party dependencies, and nothing it
+// imports depends on fmt, reflect or os, so it stays small when built
+// with TinyGo or for WebAssembly (GOOS=wasip1 GOARCH=wasm). Extras
+// that need those packages are in subpackages:
+//
+//	jcs        hashing of canonical JSON (RFC 8785)
+//	passhash   PBKDF2-HMAC-Whirlpool password hashes
+//	stream     hashing of files and readers, with cancellation
+//	valuehash  hashing of Go values
+//	whirlbig   the length counter of a Hash as a *big.Int
+//	whirlsql   database/sql support for Digest
+//
+// Optional build tags:
+//
+//	whirl_selftest  run SelfTest() at init, and refuse to hash if it fails
+//	whirl_trace     print intermediate values, like the reference code
 package whirl
 
 // end
@@ -0,0 +1,157 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                 zr-whirl/[module_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//  to test the structure of the package use:
+//      go test --run Test_modl_
+
+// forbiddenDeps must not be among the dependencies of the package,
+// direct or indirect. They are large, or pull in fmt, and would bloat
+// TinyGo and WebAssembly binaries that only need the hash. The
+// extras that need them live in subpackages (jcs, passhash, stream,
+// valuehash, whirlbig and whirlsql).
+var forbiddenDeps = []string{
+	"encoding/hex",
+	"encoding/json",
+	"fmt",
+	"math/big",
+	"os",
+	"reflect",
+}
+
+// wasmSizeBudget is how much larger a minimal program that calls
+// Sum512() may be than the same program without it, when built with
+// GOOS=wasip1 GOARCH=wasm. With Go 1.27 the difference is about
+// 60 KB (85 KB with the whirl_selftest tag), on top of 2.1 MB
+// for the program that only writes to os.Stdout.
+const wasmSizeBudget = 96 * 1024
+
+// go test --run Test_modl_Deps_
+func Test_modl_Deps_(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	// check what the linker sees, not what each file imports:
+	// a single file using fmt would link it into every program
+	for _, goos := range []string{"", "wasip1"} {
+		cmd := exec.Command(goTool, "list", "-deps", ".")
+		if goos != "" {
+			cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH=wasm")
+		}
+		out, err := cmd.Output()
+		if err != nil && goos != "" {
+			t.Logf("can't list the dependencies for GOOS=%s", goos)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("go list failed: %v", err)
+		}
+		deps := map[string]bool{}
+		for _, path := range strings.Fields(string(out)) {
+			deps[path] = true
+			if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") &&
+				!strings.HasPrefix(path, "github.com/balacode/zr-whirl") {
+				t.Errorf("depends on third-party package %s", path)
+			}
+		}
+		for _, path := range forbiddenDeps {
+			if deps[path] {
+				t.Errorf("GOOS=%s: depends on %s", goos, path)
+			}
+		}
+	}
+	// the trace printing is the only part of the package using fmt
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "trace.go", nil, parser.ImportsOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Imports) != 1 || file.Imports[0].Path.Value != `"fmt"` {
+		t.Errorf("trace.go should only import fmt")
+	}
+} //                                                             Test_modl_Deps_
+
+// go test --run Test_modl_WasmSize_
+func Test_modl_WasmSize_(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping WebAssembly build in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	targets, err := exec.Command(goTool, "tool", "dist", "list").Output()
+	if err != nil || !strings.Contains(string(targets), "wasip1/wasm") {
+		t.Skip("toolchain can't build for wasip1/wasm")
+	}
+	pkgDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "whirl-wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	gomod := "module wasmtest\n\ngo 1.21\n\n" +
+		"require github.com/balacode/zr-whirl v0.0.0\n\n" +
+		"replace github.com/balacode/zr-whirl => " + pkgDir + "\n"
+	empty := "package main\n\n" +
+		"import \"os\"\n\n" +
+		"func main() {\n" +
+		"\tos.Stdout.Write([]byte(\"abc\"))\n}\n"
+	sum := "package main\n\n" +
+		"import (\n\t\"os\"\n\n" +
+		"\twhirl \"github.com/balacode/zr-whirl\"\n)\n\n" +
+		"func main() {\n" +
+		"\tdigest := whirl.Sum512([]byte(\"abc\"))\n" +
+		"\tos.Stdout.Write(digest[:])\n}\n"
+	build := func(name, main string) int64 {
+		err := ioutil.WriteFile(filepath.Join(dir, "go.mod"),
+			[]byte(gomod), 0600)
+		if err == nil {
+			err = ioutil.WriteFile(filepath.Join(dir, "main.go"),
+				[]byte(main), 0600)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := filepath.Join(dir, name)
+		cmd := exec.Command(goTool, "build", "-o", out, ".")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm",
+			"GOFLAGS=-mod=mod")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("build failed: %v\n%s", err, output)
+		}
+		info, err := os.Stat(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.Size()
+	}
+	base := build("empty.wasm", empty)
+	size := build("whirl.wasm", sum)
+	if size-base > wasmSizeBudget {
+		t.Errorf("Sum512 adds %d bytes to a wasip1 binary; budget is %d",
+			size-base, wasmSizeBudget)
+	}
+	t.Logf("wasip1 binary size: %d bytes, %d without whirl", size, base)
+} //                                                         Test_modl_WasmSize_
+
+// end
@@ -0,0 +1,81 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package            zr-whirl/[nessie_fuzz_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build go1.18 && cgo
+// +build go1.18,cgo
+
+package whirl
+
+import (
+	"testing"
+
+	"github.com/balacode/zr-whirl/internal/nessie"
+)
+
+//  to run the fuzzers in nessie_fuzz_test.go use:
+//      go test --run - --fuzz Fuzz_ness_Add_
+//      go test --run - --fuzz Fuzz_ness_Bits_
+//
+//  They compare the Go code with the reference C code in internal/nessie,
+//  like the tests in nessie_test.go, and are kept in this file because
+//  testing.F needs Go 1.18, while go.mod allows Go 1.17.
+
+// go test --run - --fuzz Fuzz_ness_Add_
+func Fuzz_ness_Add_(f *testing.F) {
+	// 'plan' splits 'data' into pieces: each byte of the plan
+	// is the length in bits of the next piece
+	f.Add([]byte("abc"), []byte{3, 5, 16})
+	f.Add(make([]byte, 200), []byte{255, 255, 255, 255, 255, 255, 1})
+	f.Add([]byte("message digest"), []byte{})
+	f.Fuzz(func(t *testing.T, data []byte, plan []byte) {
+		w, ref := New(), nessie.New()
+		for _, bits := range plan {
+			n := (int(bits) + 7) / 8
+			if n > len(data) {
+				break
+			}
+			appendBytes(data[:n], uint64(bits), &w)
+			ref.Add(data[:n], uint64(bits))
+			data = data[n:]
+		}
+		w.Write(data)
+		ref.Add(data, uint64(8*len(data)))
+		if !sameState(&w, ref.State()) {
+			t.Fatalf("state differs from C:\n Go %+v\n C  %+v",
+				goState(&w), ref.State())
+		}
+		var digest [cDigestBytes]byte
+		finalize(&w, digest[:])
+		if expect := ref.Finalize(); digest != expect {
+			t.Fatalf("digest differs from C:\n Go %X\n C  %X", digest, expect)
+		}
+	})
+} //                                                              Fuzz_ness_Add_
+
+// go test --run - --fuzz Fuzz_ness_Bits_
+func Fuzz_ness_Bits_(f *testing.F) {
+	// a single message of any length in bits, after a prefix
+	// that leaves 'skew' bits in the buffer
+	f.Add([]byte("abc"), uint16(24), uint8(0))
+	f.Add(make([]byte, 100), uint16(777), uint8(7))
+	f.Fuzz(func(t *testing.T, data []byte, bits uint16, skew uint8) {
+		if int(bits) > 8*len(data) {
+			bits = uint16(8 * len(data))
+		}
+		w, ref := New(), nessie.New()
+		prefix := []byte{0xA5}
+		appendBytes(prefix, uint64(skew&7), &w)
+		ref.Add(prefix, uint64(skew&7))
+		appendBytes(data, uint64(bits), &w)
+		ref.Add(data, uint64(bits))
+		var digest [cDigestBytes]byte
+		finalize(&w, digest[:])
+		if expect := ref.Finalize(); digest != expect {
+			t.Fatalf("digest differs from C:\n Go %X\n C  %X", digest, expect)
+		}
+	})
+} //                                                             Fuzz_ness_Bits_
+
+// end
@@ -0,0 +1,189 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                 zr-whirl/[nessie_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build cgo
+// +build cgo
+
+package whirl
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/balacode/zr-whirl/internal/nessie"
+)
+
+//  to test all items in nessie_test.go use:
+//      go test --run Test_ness_
+//
+//  The fuzzers are in nessie_fuzz_test.go.
+//
+//  These tests compare the Go code with the C code in internal/nessie:
+//  PHP's copy of the reference code, vendored byte for byte, and
+//  Whirlpool.c, a transcription of the reference code that also
+//  hashes partial bytes. They need cgo.
+
+// go test --run Test_ness_Tables_
+func Test_ness_Tables_(t *testing.T) {
+	tables := [8]*[256]uint64{&cC0, &cC1, &cC2, &cC3, &cC4, &cC5, &cC6, &cC7}
+	for k, table := range tables {
+		for i, n := range table {
+			if expect := nessie.Table(k, i); n != expect {
+				t.Errorf("cC%d[%d]: got %016X; C has %016X", k, i, n, expect)
+			}
+		}
+	}
+	for r := range rc {
+		if expect := nessie.RoundConstant(r); rc[r] != expect {
+			t.Errorf("rc[%d]: got %016X; C has %016X", r, rc[r], expect)
+		}
+	}
+} //                                                           Test_ness_Tables_
+
+// go test --run Test_ness_Differential_
+func Test_ness_Differential_(t *testing.T) {
+	rnd := rand.New(rand.NewSource(10118))
+	iterations := 2000
+	if testing.Short() {
+		iterations = 200
+	}
+	for i := 0; i < iterations; i++ {
+		// a message of up to 8 pieces of random lengths in bits,
+		// so pieces often start and end in the middle of a byte
+		w, ref := New(), nessie.New()
+		pieces := 1 + rnd.Intn(8)
+		for p := 0; p < pieces; p++ {
+			var bits uint64
+			switch rnd.Intn(4) {
+			case 0:
+				bits = uint64(rnd.Intn(16))
+			case 1:
+				bits = uint64(8 * rnd.Intn(200))
+			default:
+				bits = uint64(rnd.Intn(1600))
+			}
+			data := make([]byte, (bits+7)/8)
+			rnd.Read(data)
+			appendBytes(data, bits, &w)
+			ref.Add(data, bits)
+			if !sameState(&w, ref.State()) {
+				t.Fatalf("iteration %d, piece %d (%d bits):"+
+					" state differs from C:\n Go %+v\n C  %+v",
+					i, p+1, bits, goState(&w), ref.State())
+			}
+		}
+		var digest [cDigestBytes]byte
+		finalize(&w, digest[:])
+		if expect := ref.Finalize(); digest != expect {
+			t.Fatalf("iteration %d: digest differs from C:\n Go %X\n C  %X",
+				i, digest, expect)
+		}
+	}
+} //                                                     Test_ness_Differential_
+
+// go test --run Test_ness_Upstream_
+func Test_ness_Upstream_(t *testing.T) {
+	// compare the Go code and Whirlpool.c with the vendored PHP code,
+	// which only hashes whole bytes
+	rnd := rand.New(rand.NewSource(10118))
+	iterations := 2000
+	if testing.Short() {
+		iterations = 200
+	}
+	for i := 0; i < iterations; i++ {
+		w, ref, up := New(), nessie.New(), nessie.NewUpstream()
+		pieces := 1 + rnd.Intn(8)
+		for p := 0; p < pieces; p++ {
+			data := make([]byte, rnd.Intn(200))
+			rnd.Read(data)
+			w.Write(data)
+			ref.Add(data, uint64(8*len(data)))
+			up.Write(data)
+			if !sameState(&w, up.State()) || ref.State() != up.State() {
+				t.Fatalf("iteration %d, piece %d (%d bytes):"+
+					" state differs from PHP:\n Go %+v\n C  %+v\n PHP %+v",
+					i, p+1, len(data), goState(&w), ref.State(), up.State())
+			}
+		}
+		var digest [cDigestBytes]byte
+		finalize(&w, digest[:])
+		expect := up.Finalize()
+		if digest != expect || ref.Finalize() != expect {
+			t.Fatalf("iteration %d: digest differs from PHP:\n Go %X\n PHP %X",
+				i, digest, expect)
+		}
+	}
+} //                                                         Test_ness_Upstream_
+
+// go test --run Test_ness_Vendored_
+func Test_ness_Vendored_(t *testing.T) {
+	// the files in internal/nessie/php must match the sha256
+	// sums recorded when they were vendored (see doc.go)
+	dir := filepath.Join("internal", "nessie", "php")
+	sums, err := os.Open(filepath.Join(dir, "SHA256SUMS"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sums.Close()
+	n := 0
+	for scan := bufio.NewScanner(sums); scan.Scan(); n++ {
+		fields := strings.Fields(scan.Text())
+		if len(fields) != 2 {
+			t.Fatalf("invalid line in SHA256SUMS: %q", scan.Text())
+		}
+		data, err := os.ReadFile(filepath.Join(dir, fields[1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256(data)
+		if got := hex.EncodeToString(sum[:]); got != fields[0] {
+			t.Errorf("%s was changed: sha256 is %s", fields[1], got)
+		}
+	}
+	if n == 0 {
+		t.Errorf("SHA256SUMS lists no files")
+	}
+} //                                                         Test_ness_Vendored_
+
+// go test --run Test_ness_Vectors_
+func Test_ness_Vectors_(t *testing.T) {
+	// Whirlpool.c is a transcription, so check it against the ISO
+	// test vectors directly, not only against the other code
+	// (Test_stst_Vectors_ checks selfTestVectors against the file)
+	for i, vector := range selfTestVectors {
+		input := []byte(vector.input)
+		hash := nessie.New()
+		hash.Add(input, uint64(len(input))*8)
+		digest := hash.Finalize()
+		got := hex.EncodeToString(digest[:])
+		if !strings.EqualFold(got, vector.expect) {
+			t.Errorf("TEST %d FAILED: got %s", i, got)
+		}
+	}
+} //                                                          Test_ness_Vectors_
+
+// goState returns the fields of a Hash that the C struct also has.
+func goState(ob *Hash) nessie.State {
+	return nessie.State{
+		BitLength:  ob.bitLength,
+		Buffer:     ob.buffer,
+		BufferBits: ob.bufferBits,
+		BufferPos:  ob.bufferPos,
+		Hash:       ob.hash,
+	}
+} //                                                                     goState
+
+// sameState returns true if a Hash has the same state as the C struct.
+func sameState(ob *Hash, state nessie.State) bool {
+	return goState(ob) == state
+} //                                                                   sameState
+
+// end
@@ -0,0 +1,24 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                     zr-whirl/[notrace.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build !whirl_trace
+// +build !whirl_trace
+
+package whirl
+
+// The trace functions do nothing unless the package is built with
+// the whirl_trace tag (see trace.go). Calls to them are guarded by
+// cTraceIntermediateValues, so the compiler removes them.
+
+// cTraceIntermediateValues enables printing of intermediate values.
+const cTraceIntermediateValues = false
+
+func traceInitial(hash *[cDigestBytes / 8]uint64) {}
+func traceBlock(buffer *[cWBlockBytes]byte)       {}
+func traceKey0(K, state *[8]uint64)               {}
+func traceRound(r int, K, state *[8]uint64)       {}
+func traceOutput(hash *[cDigestBytes / 8]uint64)  {}
+
+// end
@@ -0,0 +1,347 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package           zr-whirl/[passhash/password.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package passhash stores passwords as PBKDF2-HMAC-Whirlpool hashes,
+// and upgrades the legacy digests made by whirl.HashOfString().
+// It uses crypto/rand, which pulls fmt into package whirl's
+// dependencies, so it lives in its own package.
+package passhash
+
+// # Contents:
+//
+// # Strong Password Hashes
+//   HashPassword(password string) (string, error)
+//   HashPasswordWithCost(password string, iterations int) (string, error)
+//   VerifyPassword(password, encoded string) (bool, error)
+//
+// # Legacy Salted Digests
+//   VerifyLegacy(password string, salt, digest []byte) bool
+//   UpgradeLegacy(password string, salt, digest []byte) (string, error)
+//   WrapLegacy(salt, digest []byte) (string, error)
+//   WrapLegacyWithCost(salt, digest []byte, iterations int) (string, error)
+//
+// # Internal Functions
+//   checkIterations(iterations int) error
+//   encodePassword(prefix string, iterations int, fields ...[]byte) string
+//   hmacKeys(key []byte) (inner, outer whirl.Hash)
+//   newSalt() ([]byte, error)
+//   pbkdf2(password, salt []byte, iterations, keyLen int) []byte
+//   wipeBlock(ar *[whirl.BlockSize]byte)
+//   wipeDigest(ar *[whirl.Size]byte)
+//
+// -----------------------------------------------------------------------------
+//
+// Strong password hashes use PBKDF2 (RFC 8018) with HMAC-Whirlpool
+// as the pseudo-random function, and are stored as strings:
+//
+//   $pbkdf2-whirlpool$i=<iterations>$<salt>$<key>
+//
+// Digests created by whirl.HashOfString(password, salt), which is
+// Whirlpool(salt || password), can be checked with VerifyLegacy()
+// and replaced by a strong hash with UpgradeLegacy() after a user
+// logs in successfully. To upgrade stored digests in bulk without
+// knowing the passwords, WrapLegacy() runs the legacy digest
+// through PBKDF2 and stores the legacy salt alongside:
+//
+//   $pbkdf2-whirlpool-legacy$i=<iterations>$<legacy salt>$<salt>$<key>
+//
+// All binary fields are encoded with unpadded standard base64.
+// VerifyPassword() accepts both forms.
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// DefaultPasswordIterations is the PBKDF2 iteration
+// count used by HashPassword() and WrapLegacy().
+const DefaultPasswordIterations = 100000
+
+// MaxPasswordIterations is the highest PBKDF2 iteration count
+// accepted, so that a corrupted or planted hash can not make
+// VerifyPassword() run for long. It is ten times the default,
+// which takes a few seconds per call.
+const MaxPasswordIterations = 1000000
+
+const (
+	passwordKeyBytes     = whirl.Size
+	passwordSaltBytes    = 16
+	passwordPrefix       = "$pbkdf2-whirlpool$"
+	passwordLegacyPrefix = "$pbkdf2-whirlpool-legacy$"
+)
+
+var (
+	// ErrPasswordFormat is returned when an encoded
+	// password hash can not be parsed.
+	ErrPasswordFormat = errors.New("passhash: invalid password hash format")
+
+	// ErrPasswordMismatch is returned by UpgradeLegacy() when
+	// the password does not match the legacy digest.
+	ErrPasswordMismatch = errors.New("passhash: password does not match")
+)
+
+var passwordEncoding = base64.RawStdEncoding
+
+// -----------------------------------------------------------------------------
+// # Strong Password Hashes
+
+// HashPassword derives a strong password hash using PBKDF2-HMAC-Whirlpool
+// with a random salt and DefaultPasswordIterations iterations.
+func HashPassword(password string) (string, error) {
+	return HashPasswordWithCost(password, DefaultPasswordIterations)
+} //                                                                HashPassword
+
+// HashPasswordWithCost is like HashPassword()
+// but uses the specified number of iterations.
+func HashPasswordWithCost(password string, iterations int) (string, error) {
+	if err := checkIterations(iterations); err != nil {
+		return "", err
+	}
+	salt, err := newSalt()
+	if err != nil {
+		return "", err
+	}
+	key := pbkdf2([]byte(password), salt, iterations, passwordKeyBytes)
+	return encodePassword(passwordPrefix, iterations, salt, key), nil
+} //                                                        HashPasswordWithCost
+
+// VerifyPassword checks a password against a hash created by
+// HashPassword(), UpgradeLegacy() or WrapLegacy().
+// The derived keys are compared in constant time.
+//
+// Returns ErrPasswordFormat if 'encoded' is not a valid password hash,
+// or if its iteration count is above MaxPasswordIterations or its key
+// is longer than a digest.
+func VerifyPassword(password, encoded string) (bool, error) {
+	var prefix string
+	var fieldCount int
+	switch {
+	case strings.HasPrefix(encoded, passwordPrefix):
+		prefix, fieldCount = passwordPrefix, 2
+	case strings.HasPrefix(encoded, passwordLegacyPrefix):
+		prefix, fieldCount = passwordLegacyPrefix, 3
+	default:
+		return false, ErrPasswordFormat
+	}
+	parts := strings.Split(encoded[len(prefix):], "$")
+	if len(parts) != 1+fieldCount || !strings.HasPrefix(parts[0], "i=") {
+		return false, ErrPasswordFormat
+	}
+	iterations, err := strconv.Atoi(parts[0][2:])
+	if err != nil || checkIterations(iterations) != nil {
+		return false, ErrPasswordFormat
+	}
+	fields := make([][]byte, fieldCount)
+	for i, part := range parts[1:] {
+		fields[i], err = passwordEncoding.DecodeString(part)
+		if err != nil {
+			return false, ErrPasswordFormat
+		}
+	}
+	input := []byte(password)
+	if prefix == passwordLegacyPrefix {
+		input = whirl.HashOfString(password, fields[0])
+		fields = fields[1:]
+	}
+	salt, key := fields[0], fields[1]
+	if len(key) == 0 || len(key) > passwordKeyBytes {
+		return false, ErrPasswordFormat
+	}
+	got := pbkdf2(input, salt, iterations, len(key))
+	return subtle.ConstantTimeCompare(got, key) == 1, nil
+} //                                                              VerifyPassword
+
+// -----------------------------------------------------------------------------
+// # Legacy Salted Digests
+
+// VerifyLegacy checks a password against a digest that was
+// created with HashOfString(password, salt). The digests
+// are compared in constant time.
+func VerifyLegacy(password string, salt, digest []byte) bool {
+	got := whirl.HashOfString(password, salt)
+	return subtle.ConstantTimeCompare(got, digest) == 1
+} //                                                                VerifyLegacy
+
+// UpgradeLegacy verifies a password against a legacy digest
+// (see VerifyLegacy) and, if it matches, returns a new strong
+// password hash made by HashPassword() to replace the stored digest.
+//
+// Returns ErrPasswordMismatch if the password does not match.
+func UpgradeLegacy(password string, salt, digest []byte) (string, error) {
+	if !VerifyLegacy(password, salt, digest) {
+		return "", ErrPasswordMismatch
+	}
+	return HashPassword(password)
+} //                                                               UpgradeLegacy
+
+// WrapLegacy converts a legacy digest made by HashOfString(password, salt)
+// into the wrapped strong format, without needing the password.
+// It uses DefaultPasswordIterations iterations.
+func WrapLegacy(salt, digest []byte) (string, error) {
+	return WrapLegacyWithCost(salt, digest, DefaultPasswordIterations)
+} //                                                                  WrapLegacy
+
+// WrapLegacyWithCost is like WrapLegacy()
+// but uses the specified number of iterations.
+func WrapLegacyWithCost(
+	salt, digest []byte,
+	iterations int,
+) (string, error) {
+	if err := checkIterations(iterations); err != nil {
+		return "", err
+	}
+	if len(digest) != whirl.Size {
+		return "", errors.New("passhash: legacy digest must be " +
+			strconv.Itoa(whirl.Size) + " bytes long")
+	}
+	newSalt, err := newSalt()
+	if err != nil {
+		return "", err
+	}
+	key := pbkdf2(digest, newSalt, iterations, passwordKeyBytes)
+	return encodePassword(
+		passwordLegacyPrefix, iterations, salt, newSalt, key,
+	), nil
+} //                                                          WrapLegacyWithCost
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// checkIterations returns an error if 'iterations' is not
+// between 1 and MaxPasswordIterations.
+func checkIterations(iterations int) error {
+	if iterations < 1 {
+		return errors.New("passhash: iterations must be positive")
+	}
+	if iterations > MaxPasswordIterations {
+		return errors.New("passhash: iterations must not exceed " +
+			strconv.Itoa(MaxPasswordIterations))
+	}
+	return nil
+} //                                                             checkIterations
+
+// encodePassword joins the prefix, iteration
+// count and base64-encoded fields with '$'.
+func encodePassword(prefix string, iterations int, fields ...[]byte) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString("i=")
+	sb.WriteString(strconv.Itoa(iterations))
+	for _, field := range fields {
+		sb.WriteByte('$')
+		sb.WriteString(passwordEncoding.EncodeToString(field))
+	}
+	return sb.String()
+} //                                                              encodePassword
+
+// hmacKeys returns the HMAC inner and outer hashing states with
+// the padded key already absorbed (see RFC 2104), so that each
+// HMAC computation only needs to copy them instead of rehashing the key.
+func hmacKeys(key []byte) (inner, outer whirl.Hash) {
+	if len(key) > whirl.BlockSize {
+		sum := whirl.Sum512(key)
+		key = sum[:]
+	}
+	var ipad, opad [whirl.BlockSize]byte
+	copy(ipad[:], key)
+	copy(opad[:], key)
+	for i := range ipad {
+		ipad[i] ^= 0x36
+		opad[i] ^= 0x5c
+	}
+	inner, outer = whirl.New(), whirl.New()
+	inner.Write(ipad[:])
+	outer.Write(opad[:])
+	wipeBlock(&ipad)
+	wipeBlock(&opad)
+	return inner, outer
+} //                                                                    hmacKeys
+
+// newSalt returns a new random salt for password hashes.
+func newSalt() ([]byte, error) {
+	salt := make([]byte, passwordSaltBytes)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	return salt, nil
+} //                                                                     newSalt
+
+// pbkdf2 derives a key of 'keyLen' bytes from a password
+// using PBKDF2 with HMAC-Whirlpool (RFC 8018, section 5.2).
+func pbkdf2(password, salt []byte, iterations, keyLen int) []byte {
+	var (
+		inner, outer = hmacKeys(password)
+		h            whirl.Hash
+		ret          = make([]byte, 0, keyLen)
+		u            [whirl.Size]byte
+		t            [whirl.Size]byte
+		counter      [4]byte
+	)
+	for block := uint32(1); len(ret) < keyLen; block++ {
+		counter[0] = byte(block >> 24)
+		counter[1] = byte(block >> 16)
+		counter[2] = byte(block >> 8)
+		counter[3] = byte(block)
+		//
+		// U_1 = PRF(password, salt || INT(block))
+		h = inner
+		h.Write(salt)
+		h.Write(counter[:])
+		h.Sum(u[:0])
+		h = outer
+		h.Write(u[:])
+		h.Sum(u[:0])
+		t = u
+		//
+		// U_i = PRF(password, U_{i-1})
+		for i := 1; i < iterations; i++ {
+			h = inner
+			h.Write(u[:])
+			h.Sum(u[:0])
+			h = outer
+			h.Write(u[:])
+			h.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		ret = append(ret, t[:]...)
+	}
+	// the states hold the keyed HMAC pads, and the
+	// buffers and the tail of 'ret' hold derived bytes
+	inner.Zeroize()
+	outer.Zeroize()
+	h.Zeroize()
+	wipeDigest(&u)
+	wipeDigest(&t)
+	for i := keyLen; i < len(ret); i++ {
+		ret[i] = 0
+	}
+	return ret[:keyLen]
+} //                                                                      pbkdf2
+
+// wipeBlock clears a padded HMAC key. It is not inlined, so
+// the compiler can not remove the stores as dead code.
+//
+//go:noinline
+func wipeBlock(ar *[whirl.BlockSize]byte) {
+	*ar = [whirl.BlockSize]byte{}
+} //                                                                   wipeBlock
+
+// wipeDigest clears a buffer of derived key bytes, like wipeBlock().
+//
+//go:noinline
+func wipeDigest(ar *[whirl.Size]byte) {
+	*ar = [whirl.Size]byte{}
+} //                                                                  wipeDigest
+
+// end
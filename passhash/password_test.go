@@ -0,0 +1,226 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package      zr-whirl/[passhash/password_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package passhash
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"hash"
+	"strings"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in password.go use:
+//      go test --run Test_password_
+
+const testPasswordCost = 50
+
+// go test --run Test_password_pbkdf2_
+func Test_password_pbkdf2_(t *testing.T) {
+	// a straightforward PBKDF2 built on the standard
+	// HMAC, to check the precomputed-key version against
+	newHash := func() hash.Hash {
+		ret := whirl.New()
+		return &ret
+	}
+	expectPBKDF2 := func(
+		password, salt []byte,
+		iterations, keyLen int,
+	) []byte {
+		var ret []byte
+		for block := 1; len(ret) < keyLen; block++ {
+			mac := hmac.New(newHash, password)
+			mac.Write(salt)
+			mac.Write([]byte{0, 0, 0, byte(block)})
+			u := mac.Sum(nil)
+			t := append([]byte{}, u...)
+			for i := 1; i < iterations; i++ {
+				mac = hmac.New(newHash, password)
+				mac.Write(u)
+				u = mac.Sum(nil)
+				for j := range t {
+					t[j] ^= u[j]
+				}
+			}
+			ret = append(ret, t...)
+		}
+		return ret[:keyLen]
+	}
+	tests := []struct {
+		password   string
+		salt       string
+		iterations int
+		keyLen     int
+	}{
+		{"password", "salt", 1, 64},
+		{"password", "salt", 2, 32},
+		{"password", "salt", 7, 100},
+		{"", "", 3, 64},
+		{strings.Repeat("long key ", 20), "NaCl", 5, 130},
+	}
+	for i, test := range tests {
+		got := pbkdf2(
+			[]byte(test.password), []byte(test.salt),
+			test.iterations, test.keyLen,
+		)
+		expect := expectPBKDF2(
+			[]byte(test.password), []byte(test.salt),
+			test.iterations, test.keyLen,
+		)
+		if !bytes.Equal(got, expect) {
+			t.Errorf("TEST %d FAILED: pbkdf2 returned %X, expected %X",
+				i+1, got, expect)
+		}
+	}
+} //                                                       Test_password_pbkdf2_
+
+// go test --run Test_password_HashPassword_
+func Test_password_HashPassword_(t *testing.T) {
+	encoded, err := HashPasswordWithCost("secret", testPasswordCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$pbkdf2-whirlpool$i=50$") {
+		t.Errorf("unexpected format: %s", encoded)
+	}
+	other, _ := HashPasswordWithCost("secret", testPasswordCost)
+	if other == encoded {
+		t.Errorf("two hashes of the same password share a salt")
+	}
+	for _, test := range []struct {
+		password string
+		expect   bool
+	}{
+		{"secret", true},
+		{"Secret", false},
+		{"", false},
+	} {
+		ok, err := VerifyPassword(test.password, encoded)
+		if err != nil || ok != test.expect {
+			t.Errorf("VerifyPassword(%q) returned %v, %v; expected %v",
+				test.password, ok, err, test.expect)
+		}
+	}
+	if _, err := HashPasswordWithCost("secret", 0); err == nil {
+		t.Errorf("zero iterations were accepted")
+	}
+	_, err = HashPasswordWithCost("secret", MaxPasswordIterations+1)
+	if err == nil {
+		t.Errorf("more than MaxPasswordIterations were accepted")
+	}
+} //                                                 Test_password_HashPassword_
+
+// go test --run Test_password_VerifyPassword_
+func Test_password_VerifyPassword_(t *testing.T) {
+	for _, encoded := range []string{
+		"",
+		"secret",
+		"$pbkdf2-sha256$i=1$c2FsdA$a2V5",
+		"$pbkdf2-whirlpool$i=1$c2FsdA",
+		"$pbkdf2-whirlpool$i=0$c2FsdA$a2V5",
+		"$pbkdf2-whirlpool$n=1$c2FsdA$a2V5",
+		"$pbkdf2-whirlpool$i=1$c2FsdA$",
+		"$pbkdf2-whirlpool$i=1$c2FsdA$a2V5$a2V5",
+		"$pbkdf2-whirlpool$i=1$c2FsdA$not*base64",
+		"$pbkdf2-whirlpool-legacy$i=1$c2FsdA$a2V5",
+		// an iteration count that would hang the caller
+		"$pbkdf2-whirlpool$i=2147483647$c2FsdA$a2V5",
+		"$pbkdf2-whirlpool$i=1000001$c2FsdA$a2V5",
+		"$pbkdf2-whirlpool$i=99999999999999999999$c2FsdA$a2V5",
+		// a key longer than a digest
+		"$pbkdf2-whirlpool$i=1$c2FsdA$" +
+			passwordEncoding.EncodeToString(make([]byte, 65)),
+	} {
+		ok, err := VerifyPassword("secret", encoded)
+		if ok || err != ErrPasswordFormat {
+			t.Errorf("VerifyPassword(%q) returned %v, %v", encoded, ok, err)
+		}
+	}
+} //                                               Test_password_VerifyPassword_
+
+// go test --run Test_password_VerifyLegacy_
+func Test_password_VerifyLegacy_(t *testing.T) {
+	salt := []byte("pepper&salt")
+	digest := whirl.HashOfString("secret", salt)
+	for _, test := range []struct {
+		password string
+		salt     []byte
+		digest   []byte
+		expect   bool
+	}{
+		{"secret", salt, digest, true},
+		{"secreT", salt, digest, false},
+		{"secret", []byte("salt"), digest, false},
+		{"secret", salt, digest[:32], false},
+		{"secret", salt, nil, false},
+	} {
+		got := VerifyLegacy(test.password, test.salt, test.digest)
+		if got != test.expect {
+			t.Errorf("VerifyLegacy(%q, %q) returned %v; expected %v",
+				test.password, test.salt, got, test.expect)
+		}
+	}
+} //                                                 Test_password_VerifyLegacy_
+
+// go test --run Test_password_UpgradeLegacy_
+func Test_password_UpgradeLegacy_(t *testing.T) {
+	salt := []byte("pepper&salt")
+	digest := whirl.HashOfString("secret", salt)
+	//
+	// a wrong password must not produce an upgraded hash
+	encoded, err := UpgradeLegacy("wrong", salt, digest)
+	if encoded != "" || err != ErrPasswordMismatch {
+		t.Errorf("UpgradeLegacy(wrong) returned %q, %v", encoded, err)
+	}
+	encoded, err = UpgradeLegacy("secret", salt, digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, passwordPrefix) {
+		t.Errorf("unexpected format: %s", encoded)
+	}
+	ok, err := VerifyPassword("secret", encoded)
+	if !ok || err != nil {
+		t.Errorf("upgraded hash did not verify: %v, %v", ok, err)
+	}
+} //                                                Test_password_UpgradeLegacy_
+
+// go test --run Test_password_WrapLegacy_
+func Test_password_WrapLegacy_(t *testing.T) {
+	salt := []byte("pepper&salt")
+	digest := whirl.HashOfString("secret", salt)
+	encoded, err := WrapLegacyWithCost(salt, digest, testPasswordCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$pbkdf2-whirlpool-legacy$i=50$") {
+		t.Errorf("unexpected format: %s", encoded)
+	}
+	if strings.Contains(encoded, passwordEncoding.EncodeToString(digest)) {
+		t.Errorf("wrapped hash contains the legacy digest")
+	}
+	for _, test := range []struct {
+		password string
+		expect   bool
+	}{
+		{"secret", true},
+		{"secreT", false},
+		{"pepper&saltsecret", false},
+	} {
+		ok, err := VerifyPassword(test.password, encoded)
+		if err != nil || ok != test.expect {
+			t.Errorf("VerifyPassword(%q) returned %v, %v; expected %v",
+				test.password, ok, err, test.expect)
+		}
+	}
+	if _, err := WrapLegacyWithCost(salt, digest[:10], 1); err == nil {
+		t.Errorf("a short legacy digest was accepted")
+	}
+} //                                                   Test_password_WrapLegacy_
+
+// end
@@ -0,0 +1,53 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                    zr-whirl/[personal.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   NewPersonalized(personalization string) Hash
+//   NewWithIV(iv [8]uint64) Hash
+//
+// -----------------------------------------------------------------------------
+//
+// A personalized hash starts from an initial value (IV) derived from
+// the personalization string P, instead of Whirlpool's all-zero IV:
+//
+//   IV = Whirlpool-512("Whirlpool-P:" || P), read as eight
+//        big-endian 64-bit words
+//
+// Since the IV is computed once, when the hash is created, it costs
+// nothing per message, unlike prefixing every message with a label.
+// An empty P gives the all-zero IV, i.e. the standard Whirlpool hash.
+//
+// The "Whirlpool-P:" prefix keeps these IVs apart from
+// the IVs of the truncated variants (see truncated.go).
+
+// personalPrefix is prepended to personalization strings to derive IVs.
+const personalPrefix = "Whirlpool-P:"
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// NewPersonalized creates a hash whose initial value is derived from
+// 'personalization', so that different applications hashing the same
+// data get independent digests. The derivation is described at the top
+// of personal.go. With a blank personalization, it is the same as New().
+func NewPersonalized(personalization string) Hash {
+	if personalization == "" {
+		return New()
+	}
+	return NewWithIV(deriveIV(personalPrefix + personalization))
+} //                                                             NewPersonalized
+
+// NewWithIV creates a hash that starts from an explicit initial value.
+// Each word holds 8 bytes of the hashing state in big-endian order.
+// An all-zero IV gives the standard Whirlpool hash.
+func NewWithIV(iv [8]uint64) Hash {
+	return newFromIV(iv, cDigestBytes)
+} //                                                                   NewWithIV
+
+// end
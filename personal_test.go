@@ -0,0 +1,61 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[personal_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"testing"
+)
+
+//  to test all items in personal.go use:
+//      go test --run Test_pers_
+
+// go test --run Test_pers_NewPersonalized_
+func Test_pers_NewPersonalized_(t *testing.T) {
+	data := []byte("abc")
+	sum := func(h Hash) []byte {
+		h.Write(data)
+		return h.Sum(nil)
+	}
+	standard := Sum512(data)
+	if got := sum(NewPersonalized("")); !bytes.Equal(got, standard[:]) {
+		t.Errorf("blank personalization changed the digest: %X", got)
+	}
+	if got := sum(NewWithIV([8]uint64{})); !bytes.Equal(got, standard[:]) {
+		t.Errorf("zero IV changed the digest: %X", got)
+	}
+	appA := sum(NewPersonalized("app A"))
+	appB := sum(NewPersonalized("app B"))
+	if bytes.Equal(appA, appB) || bytes.Equal(appA, standard[:]) {
+		t.Errorf("personalized digests are not independent")
+	}
+	if again := sum(NewPersonalized("app A")); !bytes.Equal(appA, again) {
+		t.Errorf("personalization is not deterministic")
+	}
+	// the IV must be derived as documented
+	iv := deriveIV("Whirlpool-P:app A")
+	if got := sum(NewWithIV(iv)); !bytes.Equal(got, appA) {
+		t.Errorf("NewWithIV does not match NewPersonalized")
+	}
+	// a personalization must not collide with a truncated variant's IV
+	h := NewPersonalized("256")
+	if h.hash == iv256 || len(h.Sum(nil)) != cDigestBytes {
+		t.Errorf("personalized hash looks like Whirlpool-256")
+	}
+} //                                                  Test_pers_NewPersonalized_
+
+// go test --run Test_pers_Reset_
+func Test_pers_Reset_(t *testing.T) {
+	h := NewPersonalized("app A")
+	first := h.Sum(nil)
+	h.Write([]byte("some data"))
+	h.Reset()
+	if got := h.Sum(nil); !bytes.Equal(got, first) {
+		t.Errorf("Reset did not restore the personalized IV")
+	}
+} //                                                            Test_pers_Reset_
+
+// end
@@ -0,0 +1,59 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                        zr-whirl/[pool.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   Acquire() *Hash
+//   Release(hash *Hash)
+//   SumInto(dst *[cDigestBytes]byte, data ...[]byte)
+
+import (
+	"sync"
+)
+
+// hashPool holds released hashes for reuse by Acquire().
+var hashPool = sync.Pool{
+	New: func() interface{} {
+		ret := New()
+		return &ret
+	},
+}
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// Acquire returns a standard Whirlpool hash in its initial state,
+// reusing one returned by Release() when possible. Pass the hash
+// to Release() when finished with it, and don't use it afterwards.
+func Acquire() *Hash {
+	return hashPool.Get().(*Hash)
+} //                                                                     Acquire
+
+// Release resets a hash obtained from Acquire() and makes it available
+// for reuse. The hash's data and state are cleared before it is
+// pooled, whatever its initial value was.
+func Release(hash *Hash) {
+	if hash == nil {
+		return
+	}
+	*hash = New()
+	hashPool.Put(hash)
+} //                                                                     Release
+
+// SumInto writes the Whirlpool hash of the concatenation of the 'data'
+// slices into 'dst'. Unlike HashOfBytes(), it does not allocate,
+// which makes it suitable for hot paths.
+func SumInto(dst *[cDigestBytes]byte, data ...[]byte) {
+	hash := New()
+	for _, ar := range data {
+		hash.Write(ar)
+	}
+	finalize(&hash, dst[:])
+} //                                                                     SumInto
+
+// end
@@ -0,0 +1,110 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                   zr-whirl/[pool_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"testing"
+)
+
+//  to test all items in pool.go use:
+//      go test --run Test_pool_
+//
+//  to run the benchmarks with allocation counts use:
+//      go test --run - --bench Benchmark_pool_
+
+// go test --run Test_pool_SumInto_
+func Test_pool_SumInto_(t *testing.T) {
+	var got [cDigestBytes]byte
+	SumInto(&got, []byte("abc"), nil, []byte("defgh"))
+	if expect := Sum512([]byte("abcdefgh")); got != expect {
+		t.Errorf("SumInto returned %X", got)
+	}
+	SumInto(&got)
+	if expect := Sum512(nil); got != expect {
+		t.Errorf("SumInto() returned %X", got)
+	}
+	salt, data := []byte("salt"), []byte("data")
+	allocs := testing.AllocsPerRun(100, func() {
+		SumInto(&got, salt, data)
+	})
+	if allocs != 0 {
+		t.Errorf("SumInto made %v allocations per call", allocs)
+	}
+} //                                                          Test_pool_SumInto_
+
+// go test --run Test_pool_AcquireRelease_
+func Test_pool_AcquireRelease_(t *testing.T) {
+	empty, expect := Sum512(nil), Sum512([]byte("abc"))
+	for i := 0; i < 10; i++ {
+		h := Acquire()
+		if got := h.Sum(nil); !bytes.Equal(got, empty[:]) {
+			t.Fatalf("round %d: acquired hash is not in its initial state",
+				i+1)
+		}
+		h.Write([]byte("abc"))
+		if got := h.Sum(nil); !bytes.Equal(got, expect[:]) {
+			t.Errorf("round %d: wrong digest", i+1)
+		}
+		Release(h)
+	}
+	// a released hash must be reset, even if it was personalized;
+	// it can only be inspected after Acquire() returns it again
+	var released *Hash
+	reused := 0
+	for i := 0; i < 100; i++ {
+		h := Acquire()
+		if h == released {
+			reused++
+		}
+		if *h != New() {
+			t.Fatalf("round %d: acquired hash was not reset", i+1)
+		}
+		*h = NewPersonalized("app")
+		h.Write([]byte("secret"))
+		Release(h)
+		released = h
+	}
+	if reused == 0 {
+		t.Log("the pool never returned a released hash")
+	}
+	Release(nil)
+} //                                                   Test_pool_AcquireRelease_
+
+// go test --run - --bench Benchmark_pool_HashOfBytes_
+func Benchmark_pool_HashOfBytes_(b *testing.B) {
+	salt, data := []byte("0123456789abcdef"), make([]byte, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		HashOfBytes(data, salt)
+	}
+} //                                                 Benchmark_pool_HashOfBytes_
+
+// go test --run - --bench Benchmark_pool_SumInto_
+func Benchmark_pool_SumInto_(b *testing.B) {
+	var digest [cDigestBytes]byte
+	salt, data := []byte("0123456789abcdef"), make([]byte, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SumInto(&digest, salt, data)
+	}
+} //                                                     Benchmark_pool_SumInto_
+
+// go test --run - --bench Benchmark_pool_Acquire_
+func Benchmark_pool_Acquire_(b *testing.B) {
+	var digest [cDigestBytes]byte
+	salt, data := []byte("0123456789abcdef"), make([]byte, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h := Acquire()
+		h.Write(salt)
+		h.Write(data)
+		h.Sum(digest[:0])
+		Release(h)
+	}
+} //                                                     Benchmark_pool_Acquire_
+
+// end
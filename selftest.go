@@ -0,0 +1,163 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                    zr-whirl/[selftest.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   SelfTest() error
+//
+// # Internal Functions
+//   selfTestSum(data []byte, chunk int) [cDigestBytes]byte
+//   tableDigest() [cDigestBytes]byte
+//
+// -----------------------------------------------------------------------------
+//
+// SelfTest() runs known-answer tests, for deployments that must check
+// a cryptographic module before its first use. Build with the
+// whirl_selftest tag (go build -tags whirl_selftest) to run it
+// automatically when the package is initialized. If it fails,
+// New() and every function and method that hashes data will panic,
+// including the methods of a zero-value Hash. Only SelfTest() keeps
+// working, so the failure can be diagnosed.
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// selfTestErr is the error returned by the self-test run at init
+// time when the package is built with the whirl_selftest tag.
+var selfTestErr error
+
+// selfTestTables is the digest of the tables cC0..cC7 followed by rc,
+// each entry written as a big-endian 64-bit word. See tableDigest().
+const selfTestTables = "" +
+	"f946e88faef847b0f7017bb69f6bc6ac3c4706d0b6bdd9cf69c35b6ebb98df51" +
+	"a928e71db822e491a9fe123e4d0b23fd709a299f6910a1ea2d78ba02eb3e7ced"
+
+// selfTestVectors are the first eight test vectors
+// from ISO/IEC 10118-3 (see iso-test-vectors.txt).
+var selfTestVectors = []struct {
+	input  string
+	expect string
+}{
+	{"",
+		"19FA61D75522A4669B44E39C1D2E1726C530232130D407F89AFEE0964997F7A7" +
+			"3E83BE698B288FEBCF88E3E03C4F0757EA8964E59B63D93708B138CC42A66EB3"},
+	{"a",
+		"8ACA2602792AEC6F11A67206531FB7D7F0DFF59413145E6973C45001D0087B42" +
+			"D11BC645413AEFF63A42391A39145A591A92200D560195E53B478584FDAE231A"},
+	{"abc",
+		"4E2448A4C6F486BB16B6562C73B4020BF3043E3A731BCE721AE1B303D97E6D4C" +
+			"7181EEBDB6C57E277D0E34957114CBD6C797FC9D95D8B582D225292076D4EEF5"},
+	{"message digest",
+		"378C84A4126E2DC6E56DCC7458377AAC838D00032230F53CE1F5700C0FFB4D3B" +
+			"8421557659EF55C106B4B52AC5A4AAA692ED920052838F3362E86DBD37A8903E"},
+	{"abcdefghijklmnopqrstuvwxyz",
+		"F1D754662636FFE92C82EBB9212A484A8D38631EAD4238F5442EE13B8054E41B" +
+			"08BF2A9251C30B6A0B8AAE86177AB4A6F68F673E7207865D5D9819A3DBA4EB3B"},
+	{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+		"DC37E008CF9EE69BF11F00ED9ABA26901DD7C28CDEC066CC6AF42E40F82F3A1E" +
+			"08EBA26629129D8FB7CB57211B9281A65517CC879D7B962142C65F5A7AF01467"},
+	{strings.Repeat("1234567890", 8),
+		"466EF18BABB0154D25B9D38A6414F5C08784372BCCB204D6549C4AFADB601429" +
+			"4D5BD8DF2A6C44E538CD047B2681A51A2C60481E88C5A20B2C2A80CF3A9A083B"},
+	{"abcdbcdecdefdefgefghfghighijhijk",
+		"2A987EA40F917061F5D6F0A0E4644F488A7A5A52DEEE656207C562F988E95C69" +
+			"16BDC8031BC5BE1B7B947639FE050B56939BAAA0ADFF9AE6745B7B181C3BE3FD"},
+}
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// SelfTest checks that the lookup tables are intact, and that the
+// ISO test vectors 1 to 8 produce the expected digests when the
+// input is written at once, when it is written a byte at a time,
+// and, for short inputs, with the single-block path used by
+// Sum512(). It returns nil if all tests pass.
+//
+// SelfTest can be called at any time, even after the
+// init-time self-test has failed.
+func SelfTest() error {
+	got := tableDigest()
+	if FormatDisplay(got[:], hexDisplay) != selfTestTables {
+		return errors.New("whirl: self-test failed: tables are corrupted")
+	}
+	for i, vector := range selfTestVectors {
+		input := []byte(vector.input)
+		digests := [][cDigestBytes]byte{
+			selfTestSum(input, 0),
+			selfTestSum(input, 1),
+		}
+		if len(input) < cLengthBytes {
+			digests = append(digests, sumShort(input))
+		}
+		for _, got := range digests {
+			hex := FormatDisplay(got[:], hexDisplay)
+			if !strings.EqualFold(hex, vector.expect) {
+				return errors.New("whirl: self-test failed: ISO test vector " +
+					strconv.Itoa(i+1) + " returned a wrong digest")
+			}
+		}
+	}
+	return nil
+} //                                                                    SelfTest
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// selfTestSum returns the digest of 'data', written in
+// pieces of 'chunk' bytes, or all at once if 'chunk' is 0.
+// It does not call New() or finalize(), which refuse
+// to work if the init-time self-test has failed.
+func selfTestSum(data []byte, chunk int) [cDigestBytes]byte {
+	var (
+		hash   Hash // the zero value has Whirlpool's initial state
+		digest [cDigestBytes]byte
+	)
+	if chunk == 0 {
+		chunk = len(data)
+	}
+	for len(data) > 0 {
+		n := chunk
+		if n > len(data) {
+			n = len(data)
+		}
+		appendBytes(data[:n], uint64(8*n), &hash)
+		data = data[n:]
+	}
+	finalizeUnchecked(&hash, digest[:])
+	return digest
+} //                                                                 selfTestSum
+
+// tableDigest returns the Whirlpool digest of the tables cC0..cC7
+// followed by rc, each entry written as a big-endian 64-bit word.
+func tableDigest() [cDigestBytes]byte {
+	var (
+		hash   Hash
+		word   [8]byte
+		digest [cDigestBytes]byte
+	)
+	tables := [][]uint64{
+		cC0[:], cC1[:], cC2[:], cC3[:], cC4[:], cC5[:], cC6[:], cC7[:],
+		rc[:],
+	}
+	for _, table := range tables {
+		for _, n := range table {
+			for i := 7; i >= 0; i-- {
+				word[i] = byte(n)
+				n >>= 8
+			}
+			appendBytes(word[:], 64, &hash)
+		}
+	}
+	finalizeUnchecked(&hash, digest[:])
+	return digest
+} //                                                                 tableDigest
+
+// end
@@ -0,0 +1,17 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[selftest_init.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build whirl_selftest
+// +build whirl_selftest
+
+package whirl
+
+// init runs the power-on self-test. If it fails, the package
+// refuses to hash: New() panics with the self-test error.
+func init() {
+	selfTestErr = SelfTest()
+} //                                                                        init
+
+// end
@@ -0,0 +1,116 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[selftest_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+//  to test all items in selftest.go use:
+//      go test --run Test_stst_
+
+// go test --run Test_stst_SelfTest_
+func Test_stst_SelfTest_(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatalf("SelfTest failed: %v", err)
+	}
+	// a corrupted table entry is detected
+	saved := cC3[0x5A]
+	cC3[0x5A] ^= 1
+	err := SelfTest()
+	cC3[0x5A] = saved
+	if err == nil || !strings.Contains(err.Error(), "tables") {
+		t.Errorf("corrupted table: got %v", err)
+	}
+	saved = rc[cRounds]
+	rc[cRounds] ^= 1 << 63
+	err = SelfTest()
+	rc[cRounds] = saved
+	if err == nil {
+		t.Errorf("corrupted round constant was not detected")
+	}
+	// a wrong expected digest is reported with the vector number
+	savedVector := selfTestVectors[3].expect
+	selfTestVectors[3].expect = strings.Repeat("00", cDigestBytes)
+	err = SelfTest()
+	selfTestVectors[3].expect = savedVector
+	if err == nil || !strings.Contains(err.Error(), "vector 4") {
+		t.Errorf("wrong vector: got %v", err)
+	}
+} //                                                         Test_stst_SelfTest_
+
+// go test --run Test_stst_Vectors_
+func Test_stst_Vectors_(t *testing.T) {
+	// the baked-in vectors must match the ISO test vectors file,
+	// where each digest is on a pair of lines that start with a space
+	data, err := ioutil.ReadFile("iso-test-vectors.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, " ") && len(line) > 1 {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) < 2*len(selfTestVectors) {
+		t.Fatalf("found only %d digest lines", len(lines))
+	}
+	for i, vector := range selfTestVectors {
+		expect, err := ParseDisplay(lines[2*i] + lines[2*i+1])
+		if err != nil || !strings.EqualFold(vector.expect,
+			hex.EncodeToString(expect)) {
+			t.Errorf("vector %d differs from iso-test-vectors.txt", i+1)
+		}
+		digest := Sum512([]byte(vector.input))
+		if !strings.EqualFold(vector.expect, hex.EncodeToString(digest[:])) {
+			t.Errorf("vector %d differs from Sum512", i+1)
+		}
+	}
+	got := tableDigest()
+	if hex.EncodeToString(got[:]) != selfTestTables {
+		t.Errorf("tableDigest: got %x", got)
+	}
+} //                                                          Test_stst_Vectors_
+
+// go test --run Test_stst_Refuse_
+func Test_stst_Refuse_(t *testing.T) {
+	failure := errors.New("whirl: self-test failed: test")
+	mustPanic := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != failure {
+				t.Errorf("%s did not panic after a failed self-test", name)
+			}
+		}()
+		fn()
+	}
+	selfTestErr = failure
+	defer func() { selfTestErr = nil }()
+	mustPanic("New", func() { New() })
+	mustPanic("Sum512", func() { Sum512([]byte("abc")) })
+	mustPanic("Sum512 (long)", func() { Sum512(make([]byte, 100)) })
+	//
+	// a zero-value Hash doesn't go through New()
+	var h Hash
+	mustPanic("Write", func() { h.Write([]byte("abc")) })
+	mustPanic("WriteString", func() { h.WriteString("abc") })
+	mustPanic("ReadFrom", func() { h.ReadFrom(strings.NewReader("abc")) })
+	mustPanic("Sum", func() { h.Sum(nil) })
+	mustPanic("Finalize", func() { h.Finalize(nil) })
+	//
+	// SelfTest can still be run to diagnose the failure
+	if err := SelfTest(); err != nil {
+		t.Errorf("SelfTest failed: %v", err)
+	}
+} //                                                           Test_stst_Refuse_
+
+// end
@@ -0,0 +1,106 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[stream/stream.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package stream hashes files and readers of any size with Whirlpool,
+// with cancellation and progress reports. It is kept out of package
+// whirl so that programs using only the hash do not link in os.
+package stream
+
+// # Contents:
+//
+// # Public Functions
+//   SumFile(ctx context.Context, path string, progress ...ProgressFunc)
+//       ([whirl.Size]byte, error)
+//   SumReader(ctx context.Context, r io.Reader, progress ...ProgressFunc)
+//       ([whirl.Size]byte, error)
+
+import (
+	"context"
+	"io"
+	"os"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// BufferSize is the number of bytes SumReader() and SumFile()
+// read at a time. It is a multiple of the block size, large enough
+// to keep system calls cheap relative to hashing, and small enough
+// that cancellation and progress reports happen often.
+const BufferSize = 1024 * whirl.BlockSize // 64 KiB
+
+// ProgressFunc receives the total number of bytes hashed so far.
+type ProgressFunc func(bytesDone int64)
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// SumFile returns the Whirlpool hash of the file at 'path'.
+// It works like SumReader(), but errors are returned as *os.PathError
+// values that include the path. Use errors.Is() to check for
+// context.Canceled or context.DeadlineExceeded.
+func SumFile(
+	ctx context.Context,
+	path string,
+	progress ...ProgressFunc,
+) ([whirl.Size]byte, error) {
+	var digest [whirl.Size]byte
+	file, err := os.Open(path)
+	if err != nil {
+		return digest, err // already an *os.PathError
+	}
+	defer file.Close()
+	digest, err = SumReader(ctx, file, progress...)
+	if err != nil {
+		if _, ok := err.(*os.PathError); !ok {
+			err = &os.PathError{Op: "hash", Path: path, Err: err}
+		}
+		return digest, err
+	}
+	return digest, nil
+} //                                                                     SumFile
+
+// SumReader returns the Whirlpool hash of everything read from 'r'.
+//
+// It reads BufferSize bytes at a time and checks 'ctx' before
+// each read, returning ctx.Err() once it is canceled. (A Read call
+// that blocks can not be interrupted, unless 'r' itself observes 'ctx'.)
+//
+// The optional 'progress' functions are called after each read
+// with the total number of bytes hashed so far.
+func SumReader(
+	ctx context.Context,
+	r io.Reader,
+	progress ...ProgressFunc,
+) ([whirl.Size]byte, error) {
+	var (
+		digest [whirl.Size]byte
+		hash   = whirl.New()
+		buf    = make([]byte, BufferSize)
+		done   int64
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return digest, err
+		}
+		n, err := r.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			done += int64(n)
+			for _, fn := range progress {
+				fn(done)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return digest, err
+		}
+	}
+	hash.Finalize(digest[:0])
+	return digest, nil
+} //                                                                   SumReader
+
+// end
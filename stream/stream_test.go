@@ -0,0 +1,109 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package          zr-whirl/[stream/stream_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in stream.go use:
+//      go test --run Test_strm_
+
+// go test --run Test_strm_SumReader_
+func Test_strm_SumReader_(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 20000) // 320000 bytes
+	expect := whirl.Sum512(data)
+	var reports []int64
+	got, err := SumReader(context.Background(), bytes.NewReader(data),
+		func(n int64) { reports = append(reports, n) })
+	if err != nil || got != expect {
+		t.Fatalf("SumReader returned %X, %v", got, err)
+	}
+	if len(reports) != 5 || reports[len(reports)-1] != int64(len(data)) {
+		t.Errorf("unexpected progress reports: %v", reports)
+	}
+	for i := 1; i < len(reports); i++ {
+		if reports[i] <= reports[i-1] {
+			t.Errorf("progress went backwards: %v", reports)
+		}
+	}
+	// a reader that returns few bytes at a time, and data with io.EOF
+	got, err = SumReader(context.Background(),
+		iotest.HalfReader(iotest.DataErrReader(bytes.NewReader(data[:1000]))))
+	if expect := whirl.Sum512(data[:1000]); err != nil || got != expect {
+		t.Errorf("SumReader(HalfReader) returned %X, %v", got, err)
+	}
+	failure := errors.New("read failed")
+	_, err = SumReader(context.Background(), iotest.ErrReader(failure))
+	if err != failure {
+		t.Errorf("SumReader(ErrReader) returned %v", err)
+	}
+} //                                                        Test_strm_SumReader_
+
+// go test --run Test_strm_Cancel_
+func Test_strm_Cancel_(t *testing.T) {
+	data := bytes.Repeat([]byte{1}, 10*BufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var last int64
+	_, err := SumReader(ctx, bytes.NewReader(data), func(n int64) {
+		last = n
+		if n >= 3*BufferSize {
+			cancel() // cancel in the middle of the stream
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("SumReader returned %v; expected context.Canceled", err)
+	}
+	if last != 3*BufferSize {
+		t.Errorf("hashing continued after cancellation: %d bytes", last)
+	}
+	_, err = SumReader(ctx, strings.NewReader("abc"))
+	if err != context.Canceled {
+		t.Errorf("SumReader with canceled context returned %v", err)
+	}
+} //                                                           Test_strm_Cancel_
+
+// go test --run Test_strm_SumFile_
+func Test_strm_SumFile_(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.bin")
+	data := bytes.Repeat([]byte("whirlpool "), 50000)
+	if err := ioutil.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, err := SumFile(context.Background(), path)
+	if expect := whirl.Sum512(data); err != nil || got != expect {
+		t.Errorf("SumFile returned %X, %v", got, err)
+	}
+	// errors must include the path
+	missing := filepath.Join(dir, "missing.bin")
+	_, err = SumFile(context.Background(), missing)
+	var pathErr *os.PathError
+	if !errors.As(err, &pathErr) || pathErr.Path != missing ||
+		!errors.Is(err, os.ErrNotExist) {
+		t.Errorf("SumFile(missing) returned %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = SumFile(ctx, path)
+	if !errors.As(err, &pathErr) || pathErr.Path != path ||
+		!errors.Is(err, context.Canceled) {
+		t.Errorf("SumFile(canceled) returned %v", err)
+	}
+} //                                                          Test_strm_SumFile_
+
+// end
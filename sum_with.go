@@ -0,0 +1,136 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                    zr-whirl/[sum_with.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Options
+//   SumOption struct
+//   WithContext(label string) SumOption
+//   WithPepper(pepper []byte) SumOption
+//   WithSalt(salt []byte) SumOption
+//
+// # Public Functions
+//   SumWith(data []byte, opts ...SumOption) [cDigestBytes]byte
+//
+// # Internal Functions
+//   writeField(hash *Hash, tag byte, value []byte)
+//   writeFieldHeader(hash *Hash, tag byte, length int)
+//
+// -----------------------------------------------------------------------------
+//
+// SumWith() hashes an unambiguous encoding of its inputs. Each input
+// is written as a field made of:
+//
+//   tag     1 byte, identifying the input (see below)
+//   length  8 bytes, the length of the value in bytes,
+//           as a big-endian unsigned integer
+//   value   the bytes of the input
+//
+// The fields are hashed in the order below. Optional inputs
+// that were not specified are omitted altogether; an input
+// specified with an empty value is still written, with a zero length.
+//
+//   0x01  context (optional, the bytes of the UTF-8 label string)
+//   0x02  pepper  (optional)
+//   0x03  salt    (optional)
+//   0x04  data    (always present)
+//
+// The result is the plain Whirlpool digest of the concatenated fields.
+// For example SumWith([]byte("abc"), WithSalt([]byte("xy"))) is
+// Whirlpool(03 0000000000000002 7879 04 0000000000000003 616263).
+
+// SumOption specifies an optional input for SumWith().
+// Options are plain values, so passing them does not allocate.
+// The zero SumOption is ignored.
+type SumOption struct {
+	tag   byte   // field tag, or 0 if not set
+	label string // value of a context field
+	value []byte // value of a pepper or salt field
+} //                                                                   SumOption
+
+const (
+	fieldContext = 0x01
+	fieldPepper  = 0x02
+	fieldSalt    = 0x03
+	fieldData    = 0x04
+)
+
+// -----------------------------------------------------------------------------
+// # Options
+
+// WithContext sets a label that separates the digests
+// of different uses (domains) of the same data.
+func WithContext(label string) SumOption {
+	return SumOption{tag: fieldContext, label: label}
+} //                                                                 WithContext
+
+// WithPepper sets a secret, application-wide value to mix into the digest.
+func WithPepper(pepper []byte) SumOption {
+	return SumOption{tag: fieldPepper, value: pepper}
+} //                                                                  WithPepper
+
+// WithSalt sets a salt to mix into the digest.
+func WithSalt(salt []byte) SumOption {
+	return SumOption{tag: fieldSalt, value: salt}
+} //                                                                    WithSalt
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// SumWith returns the Whirlpool hash of 'data' combined with optional
+// context, pepper and salt inputs. Unlike HashOfBytes(), each input
+// is length-prefixed, so different inputs never produce the same
+// encoding. The inputs are written directly into the hashing state
+// without being concatenated first.
+//
+// See the top of sum_with.go for a precise description of the encoding.
+func SumWith(data []byte, opts ...SumOption) [cDigestBytes]byte {
+	// the last option given for each field, indexed by tag
+	var fields [fieldData]SumOption
+	for _, opt := range opts {
+		if opt.tag != 0 {
+			fields[opt.tag] = opt
+		}
+	}
+	hash := New()
+	for _, field := range fields[fieldContext:] {
+		if field.tag == 0 {
+			continue
+		}
+		n := len(field.label) + len(field.value)
+		writeFieldHeader(&hash, field.tag, n)
+		hash.WriteString(field.label)
+		hash.Write(field.value)
+	}
+	writeField(&hash, fieldData, data)
+	var digest [cDigestBytes]byte
+	finalize(&hash, digest[:])
+	return digest
+} //                                                                     SumWith
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// writeField writes a tagged and length-prefixed value to the hash.
+func writeField(hash *Hash, tag byte, value []byte) {
+	writeFieldHeader(hash, tag, len(value))
+	hash.Write(value)
+} //                                                                  writeField
+
+// writeFieldHeader writes the tag and length of a field to the hash.
+func writeFieldHeader(hash *Hash, tag byte, length int) {
+	var header [9]byte
+	n := uint64(length)
+	header[0] = tag
+	for i := 8; i > 0; i-- {
+		header[i] = byte(n)
+		n >>= 8
+	}
+	hash.Write(header[:])
+} //                                                            writeFieldHeader
+
+// end
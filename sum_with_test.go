@@ -0,0 +1,125 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package               zr-whirl/[sum_with_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+//  to test all items in sum_with.go use:
+//      go test --run Test_sumw_
+
+// go test --run Test_sumw_SumWith_
+func Test_sumw_SumWith_(t *testing.T) {
+	unhex := func(s string) []byte {
+		ret, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ret
+	}
+	tests := []struct {
+		note   string
+		data   string
+		opts   []SumOption
+		encode string // hex of the encoding that gets hashed
+	}{
+		{
+			note:   "data only",
+			data:   "abc",
+			encode: "04" + "0000000000000003" + "616263",
+		},
+		{
+			note: "example in documentation",
+			data: "abc",
+			opts: []SumOption{WithSalt([]byte("xy"))},
+			encode: "03" + "0000000000000002" + "7879" +
+				"04" + "0000000000000003" + "616263",
+		},
+		{
+			note: "all options, specified in any order",
+			data: "",
+			opts: []SumOption{
+				WithSalt([]byte{0xFF}),
+				WithContext("ctx"),
+				WithPepper([]byte("p")),
+			},
+			encode: "01" + "0000000000000003" + "637478" +
+				"02" + "0000000000000001" + "70" +
+				"03" + "0000000000000001" + "FF" +
+				"04" + "0000000000000000",
+		},
+		{
+			note: "empty salt is still encoded",
+			data: "a",
+			opts: []SumOption{WithSalt(nil)},
+			encode: "03" + "0000000000000000" +
+				"04" + "0000000000000001" + "61",
+		},
+	}
+	for i, test := range tests {
+		got := SumWith([]byte(test.data), test.opts...)
+		expect := Sum512(unhex(test.encode))
+		if got != expect {
+			t.Errorf("TEST %d (%s) FAILED", i+1, test.note)
+		}
+	}
+} //                                                          Test_sumw_SumWith_
+
+// go test --run Test_sumw_Ambiguity_
+func Test_sumw_Ambiguity_(t *testing.T) {
+	// HashOfBytes can't tell where the salt ends and the data begins
+	a := HashOfBytes([]byte("c"), []byte("ab"))
+	b := HashOfBytes([]byte("bc"), []byte("a"))
+	if !bytes.Equal(a, b) {
+		t.Errorf("expected HashOfBytes to be ambiguous")
+	}
+	// ...but SumWith can
+	digests := [][cDigestBytes]byte{
+		SumWith([]byte("c"), WithSalt([]byte("ab"))),
+		SumWith([]byte("bc"), WithSalt([]byte("a"))),
+		SumWith([]byte("abc")),
+		SumWith([]byte("abc"), WithSalt(nil)),
+		SumWith([]byte("c"), WithPepper([]byte("ab"))),
+		SumWith([]byte("c"), WithContext("ab")),
+		SumWith([]byte("c"), WithContext("a"), WithSalt([]byte("b"))),
+	}
+	for i := range digests {
+		for j := i + 1; j < len(digests); j++ {
+			if digests[i] == digests[j] {
+				t.Errorf("digests %d and %d are the same", i+1, j+1)
+			}
+		}
+	}
+} //                                                        Test_sumw_Ambiguity_
+
+// go test --run Test_sumw_Allocs_
+func Test_sumw_Allocs_(t *testing.T) {
+	data, salt := []byte("message digest"), []byte("salt")
+	allocs := testing.AllocsPerRun(100, func() {
+		SumWith(data, WithSalt(salt), WithContext("ctx"))
+	})
+	if allocs != 0 {
+		t.Errorf("SumWith made %v allocations per call; expected 0", allocs)
+	}
+} //                                                           Test_sumw_Allocs_
+
+// go test --run Test_sumw_HashOfBytes_
+func Test_sumw_HashOfBytes_(t *testing.T) {
+	// HashOfBytes must keep returning the same digests
+	data, salt := []byte("message digest"), []byte("salt")
+	expect := Sum512(append(append([]byte{}, salt...), data...))
+	if got := HashOfBytes(data, salt); !bytes.Equal(got, expect[:]) {
+		t.Errorf("HashOfBytes returned %X; expected %X", got, expect)
+	}
+	if got := HashOfString(string(data), salt); !bytes.Equal(got, expect[:]) {
+		t.Errorf("HashOfString returned %X; expected %X", got, expect)
+	}
+} //                                                      Test_sumw_HashOfBytes_
+
+// end
@@ -0,0 +1,126 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                       zr-whirl/[trace.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+//go:build whirl_trace
+// +build whirl_trace
+
+package whirl
+
+// # Contents:
+//
+// # Trace Functions
+//   traceInitial(hash *[cDigestBytes / 8]uint64)
+//   traceBlock(buffer *[cWBlockBytes]byte)
+//   traceKey0(K, state *[8]uint64)
+//   traceRound(r int, K, state *[8]uint64)
+//   traceOutput(hash *[cDigestBytes / 8]uint64)
+//   traceKeyState(K, state *[8]uint64)
+//   traceWords(words *[8]uint64)
+//
+// -----------------------------------------------------------------------------
+//
+// Building with the whirl_trace tag (go test -tags whirl_trace) prints
+// the intermediate values of every block, in the same layout as the
+// reference implementation's trace. It is kept in this file so that
+// normal builds of the package don't depend on fmt.
+
+import (
+	"fmt"
+)
+
+// cTraceIntermediateValues enables printing of intermediate values.
+const cTraceIntermediateValues = true
+
+// -----------------------------------------------------------------------------
+// # Trace Functions
+
+// traceInitial prints the initial hash value.
+func traceInitial(hash *[cDigestBytes / 8]uint64) {
+	fmt.Printf("Initial hash value:\r\n")
+	traceWords(hash)
+	fmt.Printf("\r\n")
+} //                                                                traceInitial
+
+// traceBlock prints the matrix derived from the block buffer.
+func traceBlock(buffer *[cWBlockBytes]byte) {
+	fmt.Printf("The 8x8 matrix Z' derived from the" +
+		" data-string is as follows.\r\n")
+	for i, b := 0, 0; i < cWBlockBytes/8; i++ {
+		fmt.Printf("    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
+			buffer[b+0], buffer[b+1], buffer[b+2], buffer[b+3],
+			buffer[b+4], buffer[b+5], buffer[b+6], buffer[b+7])
+		b += 8
+	}
+	fmt.Printf("\r\n")
+} //                                                                  traceBlock
+
+// traceKey0 prints the first round key and the cipher state.
+func traceKey0(K, state *[8]uint64) {
+	fmt.Printf("The K_0 matrix (from the initialization value IV)" +
+		" and X'' matrix are as follows.\r\n")
+	traceKeyState(K, state)
+	fmt.Printf("\r\n" +
+		"The following are (hexadecimal representations of) the" +
+		" successive values of the variables" +
+		" K_i for i = 1 to 10 and W'.\r\n\r\n")
+} //                                                                   traceKey0
+
+// traceRound prints the round key and the cipher state after round 'r'.
+func traceRound(r int, K, state *[8]uint64) {
+	fmt.Printf("i = %d:\r\n", r)
+	traceKeyState(K, state)
+	fmt.Printf("\r\n")
+} //                                                                  traceRound
+
+// traceOutput prints the hashing state after a block.
+func traceOutput(hash *[cDigestBytes / 8]uint64) {
+	fmt.Printf("The value of Y' output from the" +
+		" round-function is as follows.\r\n")
+	traceWords(hash)
+	fmt.Printf("\r\n")
+} //                                                                 traceOutput
+
+// traceKeyState prints a round key and a cipher state side by side.
+func traceKeyState(K, state *[8]uint64) {
+	for i := 0; i < cDigestBytes/8; i++ {
+		fmt.Printf(
+			"    %02X %02X %02X %02X %02X %02X %02X %02X        "+
+				"%02X %02X %02X %02X %02X %02X %02X %02X\r\n",
+			byte(K[i]>>56),
+			byte(K[i]>>48),
+			byte(K[i]>>40),
+			byte(K[i]>>32),
+			byte(K[i]>>24),
+			byte(K[i]>>16),
+			byte(K[i]>>8),
+			byte(K[i]),
+			byte(state[i]>>56),
+			byte(state[i]>>48),
+			byte(state[i]>>40),
+			byte(state[i]>>32),
+			byte(state[i]>>24),
+			byte(state[i]>>16),
+			byte(state[i]>>8),
+			byte(state[i]),
+		)
+	}
+} //                                                               traceKeyState
+
+// traceWords prints eight words, one per line.
+func traceWords(words *[8]uint64) {
+	for i := 0; i < 8; i++ {
+		fmt.Printf("    %02X %02X %02X %02X %02X %02X %02X %02X\r\n",
+			byte(words[i]>>56),
+			byte(words[i]>>48),
+			byte(words[i]>>40),
+			byte(words[i]>>32),
+			byte(words[i]>>24),
+			byte(words[i]>>16),
+			byte(words[i]>>8),
+			byte(words[i]))
+	}
+} //                                                                  traceWords
+
+// end
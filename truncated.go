@@ -0,0 +1,104 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                   zr-whirl/[truncated.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   New256() Hash
+//   New384() Hash
+//   Sum256(data []byte) [Size256]byte
+//   Sum384(data []byte) [Size384]byte
+//
+// # Internal Functions
+//   deriveIV(label string) [cDigestBytes / 8]uint64
+//   newFromIV(iv [cDigestBytes / 8]uint64, size int) Hash
+//
+// -----------------------------------------------------------------------------
+//
+// The truncated variants return the first 32 or 48 bytes of the final
+// hashing state, but start from their own initial value (IV) instead
+// of Whirlpool's all-zero IV. So a 256-bit digest is never the prefix
+// of a 512-bit or 384-bit digest of the same data.
+//
+// The IV of each variant is the Whirlpool-512 digest of the ASCII
+// label "Whirlpool-256" or "Whirlpool-384", read as eight big-endian
+// 64-bit words. So the IV of Whirlpool-256 starts with the word
+// 0x37B48E6730233AD8, and the IV of Whirlpool-384 with 0xAB6FA1146C343B77.
+
+const (
+	// Size256 is the size of a Whirlpool-256 digest in bytes.
+	Size256 = 32
+
+	// Size384 is the size of a Whirlpool-384 digest in bytes.
+	Size384 = 48
+)
+
+var (
+	iv256 = deriveIV("Whirlpool-256")
+	iv384 = deriveIV("Whirlpool-384")
+)
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// New256 creates a hash that returns 256-bit
+// (32-byte) truncated Whirlpool digests.
+func New256() Hash {
+	return newFromIV(iv256, Size256)
+} //                                                                      New256
+
+// New384 creates a hash that returns 384-bit
+// (48-byte) truncated Whirlpool digests.
+func New384() Hash {
+	return newFromIV(iv384, Size384)
+} //                                                                      New384
+
+// Sum256 returns the 256-bit truncated Whirlpool digest of 'data'.
+func Sum256(data []byte) [Size256]byte {
+	var ret [Size256]byte
+	hash := New256()
+	hash.Write(data)
+	hash.Sum(ret[:0])
+	return ret
+} //                                                                      Sum256
+
+// Sum384 returns the 384-bit truncated Whirlpool digest of 'data'.
+func Sum384(data []byte) [Size384]byte {
+	var ret [Size384]byte
+	hash := New384()
+	hash.Write(data)
+	hash.Sum(ret[:0])
+	return ret
+} //                                                                      Sum384
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// deriveIV returns the Whirlpool-512 digest of
+// 'label' as eight big-endian 64-bit words.
+func deriveIV(label string) [cDigestBytes / 8]uint64 {
+	var ret [cDigestBytes / 8]uint64
+	digest := Sum512([]byte(label))
+	for i := range ret {
+		for _, b := range digest[8*i : 8*i+8] {
+			ret[i] = ret[i]<<8 | uint64(b)
+		}
+	}
+	return ret
+} //                                                                    deriveIV
+
+// newFromIV creates a hash that starts from the given
+// initial value and returns digests of 'size' bytes.
+func newFromIV(iv [cDigestBytes / 8]uint64, size int) Hash {
+	ret := New()
+	ret.hash = iv
+	ret.iv = iv
+	ret.size = size
+	return ret
+} //                                                                   newFromIV
+
+// end
@@ -0,0 +1,142 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package              zr-whirl/[truncated_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//  to test all items in truncated.go use:
+//      go test --run Test_trnc_
+
+// go test --run Test_trnc_deriveIV_
+func Test_trnc_deriveIV_(t *testing.T) {
+	tests := []struct {
+		iv     [cDigestBytes / 8]uint64
+		expect string
+	}{
+		{iv256, "Whirlpool-256"},
+		{iv384, "Whirlpool-384"},
+	}
+	for i, test := range tests {
+		var got []byte
+		for _, word := range test.iv {
+			for shift := 56; shift >= 0; shift -= 8 {
+				got = append(got, byte(word>>uint(shift)))
+			}
+		}
+		expect := Sum512([]byte(test.expect))
+		if !bytes.Equal(got, expect[:]) {
+			t.Errorf("TEST %d FAILED: IV is not Sum512(%q)", i+1, test.expect)
+		}
+	}
+	if iv256[0] != 0x37B48E6730233AD8 || iv384[0] != 0xAB6FA1146C343B77 {
+		t.Errorf("IVs do not match the documentation")
+	}
+} //                                                         Test_trnc_deriveIV_
+
+// go test --run Test_trnc_KnownAnswers_
+func Test_trnc_KnownAnswers_(t *testing.T) {
+	tests := []struct {
+		input  string
+		sum256 string
+		sum384 string
+	}{
+		{
+			input: "",
+			sum256: "33D9B6E3F120EF60 89336284F702967F" +
+				" D8957C5CC2E3D63B 37A2DD2D05788058",
+			sum384: "936236179978A844 2F6A574D64202E2C" +
+				" B35C200B1BB8FD7D 29D28E8213ACD230" +
+				" C1534735EFD930B2 ECBA72754D563BD4",
+		},
+		{
+			input: "a",
+			sum256: "BC94368C5126DBE4 667D639828DFA4B1" +
+				" CB6CBCFC65E14C1F 0F469B231505F9C8",
+			sum384: "6356BCF72889AEB5 305D039CA432C78A" +
+				" 1C1E4093FAC63643 FA24D27BDAB6605D" +
+				" A80F8C19A98F48D6 EA7E26AD889F5BFC",
+		},
+		{
+			input: "abc",
+			sum256: "3241428D76C60F1D 2B5EB93C5B7E0C8C" +
+				" 2DFCAA30EBEAF804 89A5F99A6CDACE9B",
+			sum384: "60705DC025DB6F0E 8FC705B8BB96352F" +
+				" 8F962298A646ACA4 B59C22E8D98C6CA3" +
+				" 3AF409A0A29B726F 5597BEDFF9C3FF3A",
+		},
+		{
+			input: "message digest",
+			sum256: "ACC79D18F4745162 A4A96D3E4D307EB7" +
+				" C1E3813204B9DBB9 20B7D8A526F48B83",
+			sum384: "1C1FFC3C730277E3 FBD3FDDB557707E5" +
+				" 17FF6B2EF524B8A9 AE87BFB8D883319F" +
+				" 80862C7D7A091F58 1388139F8BC139A1",
+		},
+		{
+			input: strings.Repeat("a", 1000000),
+			sum256: "9FE59276F50AD1E1 4E8B8F09DD721B0A" +
+				" 524323D5F6AB88B5 82BEE2CFDBAE6DDD",
+			sum384: "BD278B1AD3EDC400 46E1D21B6E2E6F5B" +
+				" 7F16162907D7A9FF 9F80AB3B15B25C58" +
+				" 7DC789B97DCF93E0 012AA34ADD6CCBA1",
+		},
+	}
+	for i, test := range tests {
+		data := []byte(test.input)
+		full := Sum512(data)
+		got256, got384 := Sum256(data), Sum384(data)
+		expect256, _ := ParseDisplay(test.sum256)
+		expect384, _ := ParseDisplay(test.sum384)
+		if !bytes.Equal(got256[:], expect256) {
+			t.Errorf("TEST %d FAILED: Sum256 returned %X", i+1, got256)
+		}
+		if !bytes.Equal(got384[:], expect384) {
+			t.Errorf("TEST %d FAILED: Sum384 returned %X", i+1, got384)
+		}
+		// a truncated digest must not be a prefix of a longer one
+		if bytes.Equal(got256[:], full[:Size256]) ||
+			bytes.Equal(got384[:], full[:Size384]) ||
+			bytes.Equal(got256[:], got384[:Size256]) {
+			t.Errorf("TEST %d: truncated digest is a prefix", i+1)
+		}
+	}
+} //                                                     Test_trnc_KnownAnswers_
+
+// go test --run Test_trnc_Hash_
+func Test_trnc_Hash_(t *testing.T) {
+	data := []byte(strings.Repeat("0123456789", 20))
+	expect256, expect384 := Sum256(data), Sum384(data)
+	h256, h384 := New256(), New384()
+	if h256.Size() != Size256 || h384.Size() != Size384 {
+		t.Errorf("Size returned %d, %d", h256.Size(), h384.Size())
+	}
+	for round := 0; round < 2; round++ {
+		h256.Write(data[:77])
+		h256.Write(data[77:])
+		h384.Write(data)
+		if got := h256.Sum(nil); !bytes.Equal(got, expect256[:]) {
+			t.Errorf("round %d: New256 hash returned %X", round+1, got)
+		}
+		if got := h384.Sum(nil); !bytes.Equal(got, expect384[:]) {
+			t.Errorf("round %d: New384 hash returned %X", round+1, got)
+		}
+		// Reset must restore the truncated variant's IV and size
+		h256.Reset()
+		h384.Reset()
+	}
+	h := New()
+	h.Write(data)
+	h.Reset()
+	if got, expect := h.Sum(nil), Sum512(nil); !bytes.Equal(got, expect[:]) {
+		t.Errorf("Reset did not restore the standard IV")
+	}
+} //                                                             Test_trnc_Hash_
+
+// end
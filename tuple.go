@@ -0,0 +1,149 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                       zr-whirl/[tuple.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   SumTuple(parts ...[]byte) [cDigestBytes]byte
+//   SumTupleCustom(customization string, parts ...[]byte) [cDigestBytes]byte
+//
+// # TupleWriter Structure and Methods
+//   TupleWriter struct
+//   NewTupleWriter(customization string) *TupleWriter
+//   (ob *TupleWriter) Sum(b []byte) []byte
+//   (ob *TupleWriter) WritePart(part []byte)
+//   (ob *TupleWriter) start(customization string)
+//
+// # Internal Functions
+//   leftEncode(hash *Hash, x uint64)
+//   rightEncode(hash *Hash, x uint64)
+//   encodeString(hash *Hash, s []byte)
+//
+// -----------------------------------------------------------------------------
+//
+// Tuples are hashed the same way as TupleHash in NIST SP 800-185,
+// with Whirlpool in place of cSHAKE. The hashed string is:
+//
+//   encode_string("TupleHash") ||
+//   encode_string(customization) ||
+//   encode_string(part_1) || ... || encode_string(part_n) ||
+//   right_encode(512)
+//
+// where encode_string(S) = left_encode(bit length of S) || S,
+// left_encode(x) is the length in bytes of the shortest big-endian
+// representation of x (at least one byte) followed by that
+// representation, and right_encode(x) is the representation
+// followed by its length.
+
+// tupleName is the function name that starts every tuple encoding.
+const tupleName = "TupleHash"
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// SumTuple returns the Whirlpool hash of a tuple of byte strings.
+// Unlike hashing the concatenated parts, moving bytes from
+// one part to another always changes the digest.
+func SumTuple(parts ...[]byte) [cDigestBytes]byte {
+	return SumTupleCustom("", parts...)
+} //                                                                    SumTuple
+
+// SumTupleCustom is like SumTuple() but also takes a customization
+// string, so that different applications hashing the same tuples
+// get unrelated digests.
+func SumTupleCustom(
+	customization string,
+	parts ...[]byte,
+) [cDigestBytes]byte {
+	tw := TupleWriter{hash: New()}
+	tw.start(customization)
+	for _, part := range parts {
+		tw.WritePart(part)
+	}
+	var digest [cDigestBytes]byte
+	rightEncode(&tw.hash, cDigestBits)
+	finalize(&tw.hash, digest[:])
+	return digest
+} //                                                              SumTupleCustom
+
+// -----------------------------------------------------------------------------
+// # TupleWriter Structure and Methods
+
+// TupleWriter hashes a tuple one part at a time. Each part is
+// encoded directly into the hashing state, so the parts don't
+// need to be kept in memory until the tuple is complete.
+type TupleWriter struct {
+	hash Hash
+} //                                                                 TupleWriter
+
+// NewTupleWriter creates a TupleWriter with the given customization
+// string, which can be blank.
+func NewTupleWriter(customization string) *TupleWriter {
+	ret := &TupleWriter{hash: New()}
+	ret.start(customization)
+	return ret
+} //                                                              NewTupleWriter
+
+// Sum appends the digest of the parts written so far to 'b' and
+// returns the resulting slice. More parts can be written afterwards.
+func (ob *TupleWriter) Sum(b []byte) []byte {
+	hash := ob.hash
+	rightEncode(&hash, cDigestBits)
+	return hash.Sum(b)
+} //                                                                         Sum
+
+// WritePart adds the next part of the tuple.
+func (ob *TupleWriter) WritePart(part []byte) {
+	encodeString(&ob.hash, part)
+} //                                                                   WritePart
+
+// start writes the function name and customization string.
+func (ob *TupleWriter) start(customization string) {
+	encodeString(&ob.hash, []byte(tupleName))
+	encodeString(&ob.hash, []byte(customization))
+} //                                                                       start
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// leftEncode writes left_encode(x) to the hash (NIST SP 800-185).
+func leftEncode(hash *Hash, x uint64) {
+	var buf [9]byte
+	n := 8
+	for n > 1 && x>>(8*uint(n-1)) == 0 {
+		n--
+	}
+	buf[0] = byte(n)
+	for i := n; i > 0; i-- {
+		buf[i] = byte(x)
+		x >>= 8
+	}
+	hash.Write(buf[:n+1])
+} //                                                                  leftEncode
+
+// rightEncode writes right_encode(x) to the hash (NIST SP 800-185).
+func rightEncode(hash *Hash, x uint64) {
+	var buf [9]byte
+	n := 8
+	for n > 1 && x>>(8*uint(n-1)) == 0 {
+		n--
+	}
+	buf[n] = byte(n)
+	for i := n - 1; i >= 0; i-- {
+		buf[i] = byte(x)
+		x >>= 8
+	}
+	hash.Write(buf[:n+1])
+} //                                                                 rightEncode
+
+// encodeString writes encode_string(s) to the hash (NIST SP 800-185).
+func encodeString(hash *Hash, s []byte) {
+	leftEncode(hash, 8*uint64(len(s)))
+	hash.Write(s)
+} //                                                                encodeString
+
+// end
@@ -0,0 +1,111 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                  zr-whirl/[tuple_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"testing"
+)
+
+//  to test all items in tuple.go use:
+//      go test --run Test_tupl_
+
+// go test --run Test_tupl_encode_
+func Test_tupl_encode_(t *testing.T) {
+	// capture what gets written to the hash by comparing
+	// with the digest of the expected bytes
+	tests := []struct {
+		encode func(*Hash)
+		expect []byte
+	}{
+		{func(h *Hash) { leftEncode(h, 0) }, []byte{1, 0}},
+		{func(h *Hash) { leftEncode(h, 255) }, []byte{1, 255}},
+		{func(h *Hash) { leftEncode(h, 256) }, []byte{2, 1, 0}},
+		{
+			func(h *Hash) { leftEncode(h, 1<<63) },
+			[]byte{8, 0x80, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{func(h *Hash) { rightEncode(h, 0) }, []byte{0, 1}},
+		{func(h *Hash) { rightEncode(h, 512) }, []byte{2, 0, 2}},
+		{func(h *Hash) { encodeString(h, nil) }, []byte{1, 0}},
+		{
+			func(h *Hash) { encodeString(h, []byte("abc")) },
+			[]byte{1, 24, 'a', 'b', 'c'},
+		},
+	}
+	for i, test := range tests {
+		h := New()
+		test.encode(&h)
+		got := h.Sum(nil)
+		expect := Sum512(test.expect)
+		if !bytes.Equal(got, expect[:]) {
+			t.Errorf("TEST %d FAILED", i+1)
+		}
+	}
+} //                                                           Test_tupl_encode_
+
+// go test --run Test_tupl_SumTuple_
+func Test_tupl_SumTuple_(t *testing.T) {
+	encoded := []byte{
+		1, 72, 'T', 'u', 'p', 'l', 'e', 'H', 'a', 's', 'h', // function name
+		1, 0, // empty customization
+		1, 8, 'a', // part 1
+		1, 0, // part 2 (empty)
+		0x02, 0x00, 2, // right_encode(512)
+	}
+	expect := Sum512(encoded)
+	got := SumTuple([]byte("a"), nil)
+	if got != expect {
+		t.Errorf("SumTuple returned %X; expected %X", got, expect)
+	}
+} //                                                         Test_tupl_SumTuple_
+
+// go test --run Test_tupl_Boundaries_
+func Test_tupl_Boundaries_(t *testing.T) {
+	// every tuple below concatenates to the same bytes,
+	// or differs only in the customization string
+	b := func(s string) []byte { return []byte(s) }
+	digests := [][cDigestBytes]byte{
+		SumTuple(b("ab"), b("c")),
+		SumTuple(b("a"), b("bc")),
+		SumTuple(b("abc")),
+		SumTuple(b("abc"), nil),
+		SumTuple(nil, b("abc")),
+		SumTuple(b("a"), b("b"), b("c")),
+		SumTupleCustom("a", b("bc")),
+		SumTupleCustom("ab", b("c")),
+		SumTupleCustom("other", b("ab"), b("c")),
+	}
+	for i := range digests {
+		for j := i + 1; j < len(digests); j++ {
+			if digests[i] == digests[j] {
+				t.Errorf("digests %d and %d are the same", i+1, j+1)
+			}
+		}
+	}
+} //                                                       Test_tupl_Boundaries_
+
+// go test --run Test_tupl_TupleWriter_
+func Test_tupl_TupleWriter_(t *testing.T) {
+	parts := [][]byte{
+		[]byte("user-42"),
+		[]byte("tenant-7"),
+		{0, 1, 2, 3},
+		bytes.Repeat([]byte("payload"), 100),
+	}
+	tw := NewTupleWriter("records")
+	for i, part := range parts {
+		tw.WritePart(part)
+		// Sum must not disturb the state between parts
+		got := tw.Sum(nil)
+		expect := SumTupleCustom("records", parts[:i+1]...)
+		if !bytes.Equal(got, expect[:]) {
+			t.Errorf("digest after part %d differs from SumTupleCustom", i+1)
+		}
+	}
+} //                                                      Test_tupl_TupleWriter_
+
+// end
@@ -0,0 +1,411 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package             zr-whirl/[valuehash/value.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package valuehash computes Whirlpool digests of Go values, such as
+// structs, maps and slices, from a canonical encoding of each value.
+// It needs reflect, so it is not part of package whirl.
+package valuehash
+
+// # Contents:
+//
+// # Public Functions
+//   SumValue(v interface{}) ([whirl.Size]byte, error)
+//
+// # Errors
+//   UnsupportedTypeError struct
+//   (ob *UnsupportedTypeError) Error() string
+//
+// # Internal Types and Methods
+//   valueEncoder struct
+//   valueField struct
+//   visitKey struct
+//   (ob *valueEncoder) enter(v reflect.Value) (visitKey, error)
+//   (ob *valueEncoder) encode(v reflect.Value) error
+//   (ob *valueEncoder) encodeMap(v reflect.Value) error
+//   (ob *valueEncoder) encodeStruct(v reflect.Value) error
+//   (ob *valueEncoder) writeTag(tag byte)
+//   (ob *valueEncoder) writeUint(tag byte, n uint64)
+//   (ob *valueEncoder) writeBytes(tag byte, data []byte)
+//   floatBits(f float64) uint64
+//   structFields(t reflect.Type) []valueField
+//
+// -----------------------------------------------------------------------------
+//
+// SumValue() hashes a canonical encoding of a Go value. Every value
+// starts with a one-byte tag that identifies its kind. Lengths and
+// numbers are written as 8-byte big-endian integers:
+//
+//   'n'  nil pointer or interface
+//   'b'  bool: one byte, 0 or 1
+//   'i'  int, int8 ... int64: the value as an int64
+//   'u'  uint, uint8 ... uint64, uintptr: the value as a uint64
+//   'f'  float32, float64: the IEEE 754 bits of the value as a float64,
+//        with -0 written as 0 and every NaN as the same quiet NaN
+//   'c'  complex64, complex128: the real and imaginary parts,
+//        each written like a float (without a tag)
+//   's'  string: length, then the bytes
+//   'x'  byte slice or array: length, then the bytes
+//   't'  value implementing encoding.TextMarshaler: length, then the text
+//   'l'  other slice or array: number of elements, then each element
+//   'm'  map: number of entries, then each key followed by its value,
+//        ordered by the bytewise order of the encoded keys; entries
+//        whose keys encode the same (NaN keys, or pointers to equal
+//        values) are ordered by their encoded values
+//   'r'  struct: number of fields, then for each field its name
+//        (length, then the bytes) followed by its value,
+//        ordered by field name
+//
+// Non-nil pointers and interfaces are encoded as the value they
+// point to or contain, and nil slices and maps the same as empty
+// ones. Since all integers are widened to 64 bits, the encoding
+// does not depend on the platform's int size.
+//
+// Struct fields are included if they are exported and not tagged
+// `whirl:"-"`. A tag like `whirl:"name"` hashes the field under
+// a different name, so that fields can be renamed in code
+// without changing digests.
+
+import (
+	"bytes"
+	"encoding"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// UnsupportedTypeError is returned by SumValue()
+// when a value contains a type that can't be hashed,
+// such as a channel or function, or a pointer cycle.
+type UnsupportedTypeError struct {
+	Type   reflect.Type
+	Reason string
+} //                                                        UnsupportedTypeError
+
+// Error returns the error message.
+func (ob *UnsupportedTypeError) Error() string {
+	return "valuehash: can not hash value of type " + ob.Type.String() +
+		": " + ob.Reason
+} //                                                                       Error
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// SumValue returns the Whirlpool hash of a canonical encoding of 'v',
+// which can be a struct, map, slice or any other value made of
+// booleans, numbers and strings. Equal values produce the same
+// digest in every process, regardless of map iteration order.
+//
+// See the top of value.go for a precise description of the encoding.
+//
+// Returns an *UnsupportedTypeError if 'v' contains
+// channels, functions, unsafe pointers or pointer cycles.
+func SumValue(v interface{}) ([whirl.Size]byte, error) {
+	var digest [whirl.Size]byte
+	hash := whirl.New()
+	enc := valueEncoder{w: &hash, visiting: map[visitKey]bool{}}
+	err := enc.encode(reflect.ValueOf(v))
+	if err != nil {
+		return digest, err
+	}
+	hash.Finalize(digest[:0])
+	return digest, nil
+} //                                                                    SumValue
+
+// -----------------------------------------------------------------------------
+// # Internal Types and Methods
+
+// valueEncoder writes the canonical encoding of values to a writer.
+type valueEncoder struct {
+	w        io.Writer
+	buf      [9]byte
+	visiting map[visitKey]bool // pointers on the current path
+} //                                                                valueEncoder
+
+// visitKey identifies a pointer, map or slice on the current path.
+// The address alone is not enough: a struct and its first field
+// have the same address, but hashing one inside the other is not
+// a cycle. For slices, the length is part of the key too.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+} //                                                                    visitKey
+
+// valueField describes a struct field included in the encoding.
+type valueField struct {
+	index int
+	name  string
+} //                                                                  valueField
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	byteType          = reflect.TypeOf(byte(0))
+)
+
+// enter adds the pointer, map or slice 'v' to the current path.
+// It returns an *UnsupportedTypeError if 'v' is already on the
+// path, which means the value is cyclic. The caller must remove
+// the returned key from ob.visiting when done with 'v'.
+func (ob *valueEncoder) enter(v reflect.Value) (visitKey, error) {
+	key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if ob.visiting[key] {
+		return key, &UnsupportedTypeError{v.Type(), "cyclic value"}
+	}
+	ob.visiting[key] = true
+	return key, nil
+} //                                                                       enter
+
+// encode writes the encoding of 'v'.
+func (ob *valueEncoder) encode(v reflect.Value) error {
+	if !v.IsValid() {
+		ob.writeTag('n')
+		return nil
+	}
+	if v.Type().Implements(textMarshalerType) && v.CanInterface() {
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) &&
+			v.IsNil() {
+			ob.writeTag('n')
+			return nil
+		}
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return err
+		}
+		ob.writeBytes('t', text)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		n := uint64(0)
+		if v.Bool() {
+			n = 1
+		}
+		ob.buf[0], ob.buf[1] = 'b', byte(n)
+		ob.w.Write(ob.buf[:2])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		ob.writeUint('i', uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		ob.writeUint('u', v.Uint())
+	case reflect.Float32, reflect.Float64:
+		ob.writeUint('f', floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		ob.writeUint('c', floatBits(real(c)))
+		ob.writeUint(0, floatBits(imag(c)))
+	case reflect.String:
+		ob.writeUint('s', uint64(v.Len()))
+		io.WriteString(ob.w, v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem() == byteType {
+			if v.Kind() == reflect.Array {
+				data := make([]byte, v.Len())
+				reflect.Copy(reflect.ValueOf(data), v)
+				ob.writeBytes('x', data)
+				break
+			}
+			ob.writeBytes('x', v.Bytes())
+			break
+		}
+		if v.Kind() == reflect.Slice && v.Pointer() != 0 {
+			// slices can refer back to themselves
+			// through interface{} elements
+			key, err := ob.enter(v)
+			if err != nil {
+				return err
+			}
+			defer delete(ob.visiting, key)
+		}
+		n := v.Len()
+		ob.writeUint('l', uint64(n))
+		for i := 0; i < n; i++ {
+			err := ob.encode(v.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		return ob.encodeMap(v)
+	case reflect.Struct:
+		return ob.encodeStruct(v)
+	case reflect.Ptr:
+		if v.IsNil() {
+			ob.writeTag('n')
+			return nil
+		}
+		key, err := ob.enter(v)
+		if err != nil {
+			return err
+		}
+		defer delete(ob.visiting, key)
+		return ob.encode(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			ob.writeTag('n')
+			return nil
+		}
+		return ob.encode(v.Elem())
+	default:
+		return &UnsupportedTypeError{v.Type(), "unsupported kind"}
+	}
+	return nil
+} //                                                                      encode
+
+// encodeMap writes the entries of a map, ordered by their encoded keys.
+func (ob *valueEncoder) encodeMap(v reflect.Value) error {
+	key, err := ob.enter(v)
+	if err != nil {
+		return err
+	}
+	defer delete(ob.visiting, key)
+	//
+	type entry struct {
+		key     []byte
+		value   reflect.Value
+		encoded []byte // the value's encoding, if its key is not unique
+	}
+	encodeTo := func(v reflect.Value) ([]byte, error) {
+		var buf bytes.Buffer
+		enc := valueEncoder{w: &buf, visiting: ob.visiting}
+		err := enc.encode(v)
+		return buf.Bytes(), err
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := encodeTo(iter.Key())
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{key: key, value: iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+	// sort.Slice leaves entries with the same key in random
+	// order, so order each such run by the encoded values
+	for i := 0; i < len(entries); {
+		j := i + 1
+		for j < len(entries) && bytes.Equal(entries[i].key, entries[j].key) {
+			j++
+		}
+		if j-i > 1 {
+			run := entries[i:j]
+			for k := range run {
+				var err error
+				run[k].encoded, err = encodeTo(run[k].value)
+				if err != nil {
+					return err
+				}
+			}
+			sort.Slice(run, func(a, b int) bool {
+				return bytes.Compare(run[a].encoded, run[b].encoded) < 0
+			})
+		}
+		i = j
+	}
+	ob.writeUint('m', uint64(len(entries)))
+	for _, e := range entries {
+		ob.w.Write(e.key)
+		if e.encoded != nil {
+			ob.w.Write(e.encoded)
+			continue
+		}
+		err := ob.encode(e.value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+} //                                                                   encodeMap
+
+// encodeStruct writes the included fields of a struct, ordered by name.
+func (ob *valueEncoder) encodeStruct(v reflect.Value) error {
+	fields := structFields(v.Type())
+	ob.writeUint('r', uint64(len(fields)))
+	for _, field := range fields {
+		ob.writeUint(0, uint64(len(field.name)))
+		io.WriteString(ob.w, field.name)
+		err := ob.encode(v.Field(field.index))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+} //                                                                encodeStruct
+
+// writeTag writes a single tag byte.
+func (ob *valueEncoder) writeTag(tag byte) {
+	ob.buf[0] = tag
+	ob.w.Write(ob.buf[:1])
+} //                                                                    writeTag
+
+// writeUint writes a tag followed by an 8-byte big-endian integer.
+// If 'tag' is zero, only the integer is written.
+func (ob *valueEncoder) writeUint(tag byte, n uint64) {
+	for i := 8; i > 0; i-- {
+		ob.buf[i] = byte(n)
+		n >>= 8
+	}
+	if tag == 0 {
+		ob.w.Write(ob.buf[1:9])
+		return
+	}
+	ob.buf[0] = tag
+	ob.w.Write(ob.buf[:9])
+} //                                                                   writeUint
+
+// writeBytes writes a tag, the length of 'data' and then 'data'.
+func (ob *valueEncoder) writeBytes(tag byte, data []byte) {
+	ob.writeUint(tag, uint64(len(data)))
+	ob.w.Write(data)
+} //                                                                  writeBytes
+
+// floatBits returns the bits of a float,
+// with all zeros and all NaNs made the same.
+func floatBits(f float64) uint64 {
+	switch {
+	case f == 0:
+		return 0
+	case math.IsNaN(f):
+		return 0x7FF8000000000001
+	}
+	return math.Float64bits(f)
+} //                                                                   floatBits
+
+// structFields returns the fields of a struct type to
+// include in the encoding, ordered by their names.
+func structFields(t reflect.Type) []valueField {
+	ret := make([]valueField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("whirl"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		ret = append(ret, valueField{index: i, name: name})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].name < ret[j].name
+	})
+	return ret
+} //                                                                structFields
+
+// end
@@ -0,0 +1,213 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package        zr-whirl/[valuehash/value_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package valuehash
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in value.go use:
+//      go test --run Test_valu_
+
+// go test --run Test_valu_Encoding_
+func Test_valu_Encoding_(t *testing.T) {
+	type record struct {
+		Name    string `whirl:"name"`
+		Count   int16
+		Skipped bool `whirl:"-"`
+		hidden  int
+	}
+	got, err := SumValue(&record{Name: "ab", Count: -2, Skipped: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := whirl.Sum512([]byte{
+		'r', 0, 0, 0, 0, 0, 0, 0, 2, // two fields, sorted by name
+		0, 0, 0, 0, 0, 0, 0, 5, 'C', 'o', 'u', 'n', 't',
+		'i', 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE,
+		0, 0, 0, 0, 0, 0, 0, 4, 'n', 'a', 'm', 'e',
+		's', 0, 0, 0, 0, 0, 0, 0, 2, 'a', 'b',
+	})
+	if got != expect {
+		t.Errorf("SumValue returned %X; expected %X", got, expect)
+	}
+} //                                                         Test_valu_Encoding_
+
+// go test --run Test_valu_MapOrder_
+func Test_valu_MapOrder_(t *testing.T) {
+	// build the same map repeatedly in different insertion
+	// orders; Go also randomizes the iteration order
+	makeMap := func(reverse bool) map[string]interface{} {
+		ret := map[string]interface{}{}
+		for i := 0; i < 100; i++ {
+			n := i
+			if reverse {
+				n = 99 - i
+			}
+			ret["key"+strconv.Itoa(n)] = map[int]float64{n: float64(n)}
+		}
+		return ret
+	}
+	first, err := SumValue(makeMap(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		got, _ := SumValue(makeMap(i%2 == 1))
+		if got != first {
+			t.Fatalf("digest changed on iteration %d", i+1)
+		}
+	}
+} //                                                         Test_valu_MapOrder_
+
+// go test --run Test_valu_DuplicateKeys_
+func Test_valu_DuplicateKeys_(t *testing.T) {
+	// distinct keys can have the same encoding; the digest
+	// must not depend on the order the map returns them in
+	x, y, z := 5, 5, 5
+	nan := math.NaN()
+	values := []interface{}{
+		map[*int]int{&x: 1, &y: 2, &z: 3},
+		map[float64]string{nan: "a", math.NaN(): "b", 1: "c", nan: "d"},
+		map[interface{}]int{&x: 1, &y: 2, "k": 3, nil: 4},
+	}
+	for i, v := range values {
+		first, err := SumValue(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < 50; j++ {
+			if got, _ := SumValue(v); got != first {
+				t.Fatalf("TEST %d: digest changed on iteration %d",
+					i+1, j+1)
+			}
+		}
+	}
+	// the values still count
+	a, _ := SumValue(map[*int]int{&x: 1, &y: 2})
+	b, _ := SumValue(map[*int]int{&x: 1, &y: 3})
+	if a == b {
+		t.Errorf("maps with different values have the same digest")
+	}
+} //                                                    Test_valu_DuplicateKeys_
+
+// go test --run Test_valu_Equivalence_
+func Test_valu_Equivalence_(t *testing.T) {
+	type inner struct{ A, B int }
+	five := 5
+	var nilSlice []string
+	var nilMap map[string]int
+	tests := []struct {
+		note string
+		a, b interface{}
+		same bool
+	}{
+		{"int sizes", int32(5), int64(5), true},
+		{"int and int via pointer", 5, &five, true},
+		{"signed and unsigned", 5, uint(5), false},
+		{"number and string", 5, "5", false},
+		{"float sizes", float32(0.5), 0.5, true},
+		{"negative zero", math.Copysign(0, -1), 0.0, true},
+		{"NaNs", math.NaN(), -math.NaN(), true},
+		{"nil and empty slice", nilSlice, []string{}, true},
+		{"nil and empty map", nilMap, map[string]int{}, true},
+		{"nil pointer and zero", (*int)(nil), 0, false},
+		{"byte slice and array", []byte("ab"), [2]byte{'a', 'b'}, true},
+		{"bytes and string", []byte("ab"), "ab", false},
+		{"list boundaries", []string{"ab", "c"}, []string{"a", "bc"}, false},
+		{"struct and pointer", inner{1, 2}, &inner{1, 2}, true},
+		{"struct fields", inner{1, 2}, inner{2, 1}, false},
+		{"bool", true, false, false},
+		{"complex", complex(1, 2), complex(2, 1), false},
+	}
+	for i, test := range tests {
+		a, errA := SumValue(test.a)
+		b, errB := SumValue(test.b)
+		if errA != nil || errB != nil {
+			t.Errorf("TEST %d (%s): errors %v, %v",
+				i+1, test.note, errA, errB)
+			continue
+		}
+		if (a == b) != test.same {
+			t.Errorf("TEST %d (%s) FAILED", i+1, test.note)
+		}
+	}
+} //                                                      Test_valu_Equivalence_
+
+// go test --run Test_valu_TextMarshaler_
+func Test_valu_TextMarshaler_(t *testing.T) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.Add(time.Second)
+	a, _ := SumValue(map[string]time.Time{"at": t1})
+	b, _ := SumValue(map[string]time.Time{"at": t2})
+	if a == b {
+		t.Errorf("different times produced the same digest")
+	}
+	c, _ := SumValue(t1)
+	d := whirl.Sum512(append([]byte{'t', 0, 0, 0, 0, 0, 0, 0, 20},
+		"2020-01-02T03:04:05Z"...))
+	if c != d {
+		t.Errorf("time was not encoded as text")
+	}
+} //                                                    Test_valu_TextMarshaler_
+
+// go test --run Test_valu_Unsupported_
+func Test_valu_Unsupported_(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	cycle := &node{}
+	cycle.Next = cycle
+	list := []interface{}{nil}
+	list[0] = list
+	for i, v := range []interface{}{
+		make(chan int),
+		func() {},
+		struct{ F func() }{},
+		map[string]interface{}{"a": []interface{}{make(chan bool)}},
+		cycle,
+		list,
+	} {
+		_, err := SumValue(v)
+		if _, ok := err.(*UnsupportedTypeError); !ok {
+			t.Errorf("TEST %d: expected *UnsupportedTypeError, got %v",
+				i+1, err)
+		}
+	}
+	// the same pointer appearing twice is not a cycle
+	shared := &node{}
+	_, err := SumValue([]*node{shared, shared})
+	if err != nil {
+		t.Errorf("shared pointer returned %v", err)
+	}
+	// a pointer to the first field of a struct has the address of
+	// the struct, but is not a cycle (regression test)
+	type inner struct{ N int }
+	type outer struct {
+		In inner
+		P  *inner
+	}
+	o := &outer{In: inner{1}}
+	o.P = &o.In
+	got, err := SumValue(o)
+	expect, _ := SumValue(&outer{In: inner{1}, P: &inner{1}})
+	if err != nil || got != expect {
+		t.Errorf("pointer to the first field returned %v", err)
+	}
+	// nor is an empty slice that shares the array of its parent
+	empty := []interface{}{nil}
+	empty[0] = empty[:0]
+	if _, err := SumValue(empty); err != nil {
+		t.Errorf("empty subslice returned %v", err)
+	}
+} //                                                      Test_valu_Unsupported_
+
+// end
@@ -0,0 +1,68 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                      zr-whirl/[verify.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Public Functions
+//   Equal(a, b []byte) bool
+//   Verify(data, expected []byte) bool
+//   VerifyReader(r io.Reader, expected []byte) (bool, error)
+//   (d Digest) Equal(other Digest) bool
+
+import (
+	"crypto/subtle"
+	"io"
+)
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// Equal compares two digests in constant time, so that the time taken
+// does not reveal how many leading bytes match. Use it instead of
+// bytes.Equal() when a digest is used as a token or MAC.
+//
+// The digests can have any size, so this works for the results of
+// Sum256() and Sum384() as well as Sum512(): pass them as slices,
+// e.g. Equal(got[:], want[:]). To compare Digest values, use
+// Digest.Equal(). Returns false if the digests differ in length,
+// or if they are empty.
+func Equal(a, b []byte) bool {
+	if len(a) == 0 {
+		return false
+	}
+	return subtle.ConstantTimeCompare(a, b) == 1
+} //                                                                       Equal
+
+// Verify hashes 'data' with Sum512() and compares the result with
+// the 'expected' digest in constant time. Pass a Digest or the
+// result of Sum512() as expected[:].
+func Verify(data, expected []byte) bool {
+	digest := Sum512(data)
+	return Equal(digest[:], expected)
+} //                                                                      Verify
+
+// VerifyReader hashes everything read from 'r' and compares the
+// result with the 'expected' Whirlpool-512 digest in constant time.
+//
+// Returns false and the error if reading from 'r' fails.
+func VerifyReader(r io.Reader, expected []byte) (bool, error) {
+	hash := New()
+	_, err := io.Copy(&hash, r)
+	if err != nil {
+		return false, err
+	}
+	var digest [cDigestBytes]byte
+	finalize(&hash, digest[:])
+	return Equal(digest[:], expected), nil
+} //                                                                VerifyReader
+
+// Equal compares the digest with another one in constant time.
+func (d Digest) Equal(other Digest) bool {
+	return subtle.ConstantTimeCompare(d[:], other[:]) == 1
+} //                                                                       Equal
+
+// end
@@ -0,0 +1,97 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                 zr-whirl/[verify_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+//  to test all items in verify.go use:
+//      go test --run Test_vrfy_
+
+// go test --run Test_vrfy_Equal_
+func Test_vrfy_Equal_(t *testing.T) {
+	sum := Sum512([]byte("abc"))
+	other := Sum512([]byte("abd"))
+	sum256, other256 := Sum256([]byte("abc")), Sum256([]byte("abd"))
+	sum384, other384 := Sum384([]byte("abc")), Sum384([]byte("abd"))
+	tests := []struct {
+		a, b   []byte
+		expect bool
+	}{
+		{sum[:], sum[:], true},
+		{sum[:], other[:], false},
+		{sum256[:], sum256[:], true},
+		{sum256[:], other256[:], false},
+		{sum384[:], sum384[:], true},
+		{sum384[:], other384[:], false},
+		{sum[:], sum[:32], false},
+		{sum[:32], sum256[:], false},
+		{[]byte{}, []byte{}, false},
+		{nil, nil, false},
+	}
+	for i, test := range tests {
+		if got := Equal(test.a, test.b); got != test.expect {
+			t.Errorf("TEST %d FAILED: Equal returned %v", i+1, got)
+		}
+	}
+	// the same digest computed twice must compare equal
+	a, b := Sum256([]byte("x")), Sum256([]byte("x"))
+	c, d := Sum384([]byte("x")), Sum384([]byte("x"))
+	if !Equal(a[:], b[:]) || !Equal(c[:], d[:]) {
+		t.Errorf("Equal rejected truncated digests of the same data")
+	}
+	digest := Digest(sum)
+	if !digest.Equal(Digest(sum)) || digest.Equal(Digest(other)) {
+		t.Errorf("Digest.Equal failed")
+	}
+} //                                                            Test_vrfy_Equal_
+
+// go test --run Test_vrfy_Verify_
+func Test_vrfy_Verify_(t *testing.T) {
+	expect := Digest(Sum512([]byte("message digest")))
+	if !Verify([]byte("message digest"), expect[:]) {
+		t.Errorf("Verify rejected the correct data")
+	}
+	if Verify([]byte("message digesT"), expect[:]) {
+		t.Errorf("Verify accepted the wrong data")
+	}
+	if Verify([]byte("message digest"), expect[:32]) {
+		t.Errorf("Verify accepted a truncated digest")
+	}
+} //                                                           Test_vrfy_Verify_
+
+// go test --run Test_vrfy_VerifyReader_
+func Test_vrfy_VerifyReader_(t *testing.T) {
+	input := strings.Repeat("a", 100000)
+	expect := Sum512([]byte(input))
+	short := Sum512([]byte(input[:1000]))
+	ok, err := VerifyReader(
+		iotest.OneByteReader(strings.NewReader(input[:1000])),
+		short[:],
+	)
+	if !ok || err != nil {
+		t.Errorf("VerifyReader(OneByteReader) returned %v, %v", ok, err)
+	}
+	ok, err = VerifyReader(strings.NewReader(input), expect[:])
+	if !ok || err != nil {
+		t.Errorf("VerifyReader returned %v, %v", ok, err)
+	}
+	ok, err = VerifyReader(strings.NewReader(input[1:]), expect[:])
+	if ok || err != nil {
+		t.Errorf("VerifyReader(wrong data) returned %v, %v", ok, err)
+	}
+	failure := errors.New("read failed")
+	ok, err = VerifyReader(iotest.ErrReader(failure), expect[:])
+	if ok || err != failure {
+		t.Errorf("VerifyReader(ErrReader) returned %v, %v", ok, err)
+	}
+} //                                                     Test_vrfy_VerifyReader_
+
+// end
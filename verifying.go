@@ -0,0 +1,158 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package                   zr-whirl/[verifying.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+// # Contents:
+//
+// # Errors
+//   ErrDigestMismatch error
+//   DigestMismatchError struct
+//   (ob *DigestMismatchError) Error() string
+//   (ob *DigestMismatchError) Is(target error) bool
+//
+// # VerifyingReader Structure and Methods
+//   VerifyingReader struct
+//   NewVerifyingReader(r io.Reader, expected []byte) *VerifyingReader
+//   (ob *VerifyingReader) Read(p []byte) (n int, err error)
+//
+// # HashingWriter Structure and Methods
+//   HashingWriter struct
+//   NewHashingWriter(w io.Writer) *HashingWriter
+//   (ob *HashingWriter) Close() error
+//   (ob *HashingWriter) Digest() (Digest, bool)
+//   (ob *HashingWriter) Write(p []byte) (n int, err error)
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+)
+
+// ErrDigestMismatch is matched by errors.Is() for every
+// *DigestMismatchError returned by a VerifyingReader.
+var ErrDigestMismatch = errors.New("whirl: digest mismatch")
+
+// DigestMismatchError is returned by a VerifyingReader, in place of
+// io.EOF, when the data read does not have the expected digest.
+type DigestMismatchError struct {
+	Expected []byte
+	Actual   []byte
+} //                                                         DigestMismatchError
+
+// Error returns the error message, including both digests.
+func (ob *DigestMismatchError) Error() string {
+	return "whirl: digest mismatch: expected " +
+		FormatDisplay(ob.Expected, hexDisplay) + ", got " +
+		FormatDisplay(ob.Actual, hexDisplay)
+} //                                                                       Error
+
+// Is makes errors.Is(err, ErrDigestMismatch) true.
+func (ob *DigestMismatchError) Is(target error) bool {
+	return target == ErrDigestMismatch
+} //                                                                          Is
+
+// -----------------------------------------------------------------------------
+// # VerifyingReader Structure and Methods
+
+// VerifyingReader passes through everything read from an underlying
+// reader while hashing it, and checks the digest when the underlying
+// reader reaches the end of its data.
+type VerifyingReader struct {
+	r        io.Reader
+	hash     Hash
+	expected []byte
+	err      error // sticky result once the end is reached
+} //                                                             VerifyingReader
+
+// NewVerifyingReader returns a reader that reads from 'r' and,
+// at the end of the data, returns io.EOF if the Whirlpool digest of
+// everything read equals 'expected', or a *DigestMismatchError if not.
+//
+// Callers must not act on the data until the end is reached without
+// an error. The digests are compared in constant time.
+func NewVerifyingReader(r io.Reader, expected []byte) *VerifyingReader {
+	return &VerifyingReader{
+		r:        r,
+		hash:     New(),
+		expected: append([]byte{}, expected...),
+	}
+} //                                                          NewVerifyingReader
+
+// Read implements io.Reader.
+func (ob *VerifyingReader) Read(p []byte) (n int, err error) {
+	if ob.err != nil {
+		return 0, ob.err
+	}
+	n, err = ob.r.Read(p)
+	ob.hash.Write(p[:n])
+	if err == io.EOF {
+		actual := ob.hash.Sum(nil)
+		if subtle.ConstantTimeCompare(actual, ob.expected) == 1 {
+			ob.err = io.EOF
+		} else {
+			ob.err = &DigestMismatchError{
+				Expected: ob.expected,
+				Actual:   actual,
+			}
+		}
+		err = ob.err
+	}
+	return n, err
+} //                                                                        Read
+
+// -----------------------------------------------------------------------------
+// # HashingWriter Structure and Methods
+
+// HashingWriter writes to an underlying writer while hashing
+// everything written. The digest is available after Close().
+type HashingWriter struct {
+	w      io.Writer
+	hash   Hash
+	digest Digest
+	closed bool
+} //                                                               HashingWriter
+
+// NewHashingWriter returns a HashingWriter that writes to 'w'.
+// If 'w' is nil, the data is only hashed.
+func NewHashingWriter(w io.Writer) *HashingWriter {
+	return &HashingWriter{w: w, hash: New()}
+} //                                                            NewHashingWriter
+
+// Close finishes hashing and closes the underlying
+// writer, if it implements io.Closer.
+func (ob *HashingWriter) Close() error {
+	if ob.closed {
+		return nil
+	}
+	ob.closed = true
+	finalize(&ob.hash, ob.digest[:])
+	if closer, ok := ob.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+} //                                                                       Close
+
+// Digest returns the digest of everything written.
+// It returns false if the writer has not been closed yet.
+func (ob *HashingWriter) Digest() (Digest, bool) {
+	return ob.digest, ob.closed
+} //                                                                      Digest
+
+// Write writes 'p' to the underlying writer and hashes the bytes
+// that were written successfully. Writing after Close() fails.
+func (ob *HashingWriter) Write(p []byte) (n int, err error) {
+	if ob.closed {
+		return 0, errors.New("whirl: write to closed HashingWriter")
+	}
+	if ob.w == nil {
+		return ob.hash.Write(p)
+	}
+	n, err = ob.w.Write(p)
+	ob.hash.Write(p[:n])
+	return n, err
+} //                                                                       Write
+
+// end
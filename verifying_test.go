@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package              zr-whirl/[verifying_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirl
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+//  to test all items in verifying.go use:
+//      go test --run Test_vfrd_
+
+// go test --run Test_vfrd_VerifyingReader_
+func Test_vfrd_VerifyingReader_(t *testing.T) {
+	data := strings.Repeat("artifact ", 10000)
+	expect := Sum512([]byte(data))
+	//
+	// correct digest: all data passes through, then io.EOF
+	r := NewVerifyingReader(iotest.HalfReader(strings.NewReader(data)),
+		expect[:])
+	got, err := ioutil.ReadAll(r)
+	if err != nil || string(got) != data {
+		t.Errorf("ReadAll returned %d bytes, %v", len(got), err)
+	}
+	if n, err := r.Read(make([]byte, 10)); n != 0 || err != io.EOF {
+		t.Errorf("Read after the end returned %d, %v", n, err)
+	}
+	// corrupted data: the mismatch replaces io.EOF
+	corrupted := data[:100] + "X" + data[101:]
+	r = NewVerifyingReader(strings.NewReader(corrupted), expect[:])
+	_, err = ioutil.ReadAll(r)
+	var mismatch *DigestMismatchError
+	if !errors.As(err, &mismatch) || !errors.Is(err, ErrDigestMismatch) {
+		t.Fatalf("ReadAll(corrupted) returned %v", err)
+	}
+	actual := Sum512([]byte(corrupted))
+	if !bytes.Equal(mismatch.Expected, expect[:]) ||
+		!bytes.Equal(mismatch.Actual, actual[:]) {
+		t.Errorf("mismatch error has wrong digests")
+	}
+	if _, err := r.Read(make([]byte, 10)); err != mismatch {
+		t.Errorf("mismatch error is not sticky: %v", err)
+	}
+	// truncated stream
+	r = NewVerifyingReader(strings.NewReader(data[:len(data)-1]), expect[:])
+	if _, err = ioutil.ReadAll(r); !errors.Is(err, ErrDigestMismatch) {
+		t.Errorf("ReadAll(truncated) returned %v", err)
+	}
+	// errors other than io.EOF are passed through
+	failure := errors.New("network down")
+	r = NewVerifyingReader(iotest.ErrReader(failure), expect[:])
+	if _, err = ioutil.ReadAll(r); err != failure {
+		t.Errorf("ReadAll(ErrReader) returned %v", err)
+	}
+} //                                                  Test_vfrd_VerifyingReader_
+
+// testCloser records whether Close was called.
+type testCloser struct {
+	bytes.Buffer
+	closed bool
+} //                                                                  testCloser
+
+// Close _ _
+func (ob *testCloser) Close() error {
+	ob.closed = true
+	return nil
+} //                                                                       Close
+
+// go test --run Test_vfrd_HashingWriter_
+func Test_vfrd_HashingWriter_(t *testing.T) {
+	var out testCloser
+	w := NewHashingWriter(&out)
+	if _, ok := w.Digest(); ok {
+		t.Errorf("Digest is available before Close")
+	}
+	io.Copy(w, strings.NewReader(strings.Repeat("tee ", 1000)))
+	w.Write([]byte("end"))
+	if err := w.Close(); err != nil || !out.closed {
+		t.Errorf("Close returned %v, closed: %v", err, out.closed)
+	}
+	got, ok := w.Digest()
+	if expect := Digest(Sum512(out.Bytes())); !ok || got != expect {
+		t.Errorf("Digest returned %v, %v", got, ok)
+	}
+	if _, err := w.Write([]byte("more")); err == nil {
+		t.Errorf("Write after Close did not fail")
+	}
+	// hashing only
+	w = NewHashingWriter(nil)
+	w.Write([]byte("abc"))
+	w.Close()
+	if got, _ := w.Digest(); got != Digest(Sum512([]byte("abc"))) {
+		t.Errorf("Digest of nil writer returned %v", got)
+	}
+} //                                                    Test_vfrd_HashingWriter_
+
+// end
@@ -0,0 +1,34 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package             zr-whirl/[whirlbig/bitlen.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package whirlbig returns the length counter of a whirl.Hash as a
+// *big.Int. It is not part of package whirl because math/big imports
+// fmt, which would make every program that uses the hash larger.
+package whirlbig
+
+// # Contents:
+//
+// # Public Functions
+//   BitLen(hash *whirl.Hash) *big.Int
+
+import (
+	"math/big"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// -----------------------------------------------------------------------------
+// # Public Functions
+
+// BitLen returns the number of bits hashed so far. Whirlpool
+// counts up to 2^256-1 bits, so unlike hash.Len() the result is
+// exact for messages longer than 2^64 bits, which can be hashed
+// with any number of calls to Write().
+func BitLen(hash *whirl.Hash) *big.Int {
+	counter := hash.BitLength()
+	return new(big.Int).SetBytes(counter[:])
+} //                                                                      BitLen
+
+// end
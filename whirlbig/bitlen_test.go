@@ -0,0 +1,38 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package        zr-whirl/[whirlbig/bitlen_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirlbig
+
+import (
+	"math/big"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in bitlen.go use:
+//      go test --run Test_bitl_
+
+// go test --run Test_bitl_BitLen_
+func Test_bitl_BitLen_(t *testing.T) {
+	h := whirl.New()
+	if BitLen(&h).Sign() != 0 {
+		t.Errorf("New hash has a nonzero length")
+	}
+	h.Write(make([]byte, 1000))
+	if got := BitLen(&h); got.Cmp(big.NewInt(8000)) != 0 {
+		t.Errorf("BitLen: got %v; want 8000", got)
+	}
+	h.Write(make([]byte, 1<<16))
+	if got := BitLen(&h); got.Cmp(big.NewInt(8000+8<<16)) != 0 {
+		t.Errorf("BitLen: got %v; want %d", got, 8000+8<<16)
+	}
+	h.Reset()
+	if BitLen(&h).Sign() != 0 {
+		t.Errorf("Reset did not clear the length")
+	}
+} //                                                           Test_bitl_BitLen_
+
+// end
@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package             zr-whirl/[whirlsql/digest.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// Package whirlsql stores Whirlpool digests with database/sql.
+// It is kept apart from package whirl, since database/sql/driver
+// depends on fmt, which the core hash does not use.
+package whirlsql
+
+// # Contents:
+//
+// # Digest Type and Methods
+//   Digest struct
+//   (d *Digest) Scan(src interface{}) error
+//   (d Digest) Value() (driver.Value, error)
+//
+// # DigestHex Type and Methods
+//   DigestHex struct
+//   (d *DigestHex) Scan(src interface{}) error
+//   (d DigestHex) Value() (driver.Value, error)
+//
+// # Internal Functions
+//   scanDigest(d *whirl.Digest, src interface{}) error
+
+import (
+	"database/sql/driver"
+	"errors"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+// -----------------------------------------------------------------------------
+// # Digest Type and Methods
+
+// Digest wraps a whirl.Digest to implement the database/sql Scanner
+// and driver.Valuer interfaces. It is stored as 64 raw bytes (e.g. in
+// a BYTEA column), and can be scanned from either raw bytes or from
+// hexadecimal text (e.g. a CHAR(128) column). Use DigestHex to store
+// digests in a text column.
+//
+// The methods of whirl.Digest are promoted, so a Digest still prints,
+// and encodes to JSON and text, as hexadecimal digits.
+type Digest struct {
+	whirl.Digest
+} //                                                                      Digest
+
+// Scan implements the database/sql Scanner interface. It accepts
+// the 64 raw bytes of a digest, or its 128 hexadecimal digits as
+// a string or byte slice. Spaces around the digits are ignored.
+func (d *Digest) Scan(src interface{}) error {
+	return scanDigest(&d.Digest, src)
+} //                                                                        Scan
+
+// Value implements the database/sql/driver Valuer
+// interface. It returns the 64 raw bytes of the digest.
+func (d Digest) Value() (driver.Value, error) {
+	return d.Digest[:], nil
+} //                                                                       Value
+
+// -----------------------------------------------------------------------------
+// # DigestHex Type and Methods
+
+// DigestHex wraps a whirl.Digest that is stored as 128 lowercase
+// hexadecimal digits, e.g. in a CHAR(128) column. Like Digest,
+// it can be scanned from either raw bytes or hexadecimal text.
+type DigestHex struct {
+	whirl.Digest
+} //                                                                   DigestHex
+
+// Scan implements the database/sql Scanner interface.
+// It accepts the same values as Digest.Scan().
+func (d *DigestHex) Scan(src interface{}) error {
+	return scanDigest(&d.Digest, src)
+} //                                                                        Scan
+
+// Value implements the database/sql/driver Valuer interface.
+// It returns the digest as a string of hexadecimal digits.
+func (d DigestHex) Value() (driver.Value, error) {
+	return d.Hex(), nil
+} //                                                                       Value
+
+// -----------------------------------------------------------------------------
+// # Internal Functions
+
+// scanDigest reads a digest from a value scanned from a database.
+// See Digest.Scan().
+func scanDigest(d *whirl.Digest, src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		if len(src) == whirl.Size {
+			copy(d[:], src)
+			return nil
+		}
+		return d.UnmarshalText(src)
+	case string:
+		return d.UnmarshalText([]byte(src))
+	case nil:
+		return errors.New("whirlsql: can not scan NULL into a Digest")
+	}
+	return errors.New("whirlsql: can not scan a value of this type" +
+		" into a Digest")
+} //                                                                  scanDigest
+
+// end
@@ -0,0 +1,180 @@
+// -----------------------------------------------------------------------------
+// ZR Library - Whirlpool Hash Package        zr-whirl/[whirlsql/digest_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package whirlsql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	whirl "github.com/balacode/zr-whirl"
+)
+
+//  to test all items in digest.go use:
+//      go test --run Test_dgst_
+
+// the ISO test vector for 'abc'
+const testDigestABC = "4e2448a4c6f486bb16b6562c73b4020b" +
+	"f3043e3a731bce721ae1b303d97e6d4c" +
+	"7181eebdb6c57e277d0e34957114cbd6" +
+	"c797fc9d95d8b582d225292076d4eef5"
+
+// go test --run Test_dgst_SQL_
+func Test_dgst_SQL_(t *testing.T) {
+	d := Digest{whirl.Sum512([]byte("abc"))}
+	v, err := d.Value()
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, d.Digest[:]) || err != nil {
+		t.Errorf("Value returned %v, %v", v, err)
+	}
+	for i, src := range []interface{}{
+		d.Digest[:],                    // BYTEA
+		testDigestABC,                  // CHAR(128)
+		[]byte(testDigestABC),          // CHAR(128) read as bytes
+		strings.ToUpper(testDigestABC), // upper case
+		testDigestABC + "  ",           // padded CHAR
+	} {
+		var got Digest
+		if err := got.Scan(src); err != nil || got != d {
+			t.Errorf("TEST %d: Scan returned %v, %v", i+1, got, err)
+		}
+	}
+	for i, src := range []interface{}{nil, 42, d.Digest[:10], "abc"} {
+		var got Digest
+		if err := got.Scan(src); err == nil {
+			t.Errorf("TEST %d: Scan(%v) did not fail", i+1, src)
+		}
+	}
+} //                                                              Test_dgst_SQL_
+
+// go test --run Test_dgst_Write_
+func Test_dgst_Write_(t *testing.T) {
+	// write both forms through database/sql, to a driver
+	// that records the values it receives
+	d := whirl.Sum512([]byte("abc"))
+	var written []driver.Value
+	db := sql.OpenDB(recorder{&written})
+	defer db.Close()
+	_, err := db.Exec("INSERT", Digest{d}, DigestHex{d})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 2 {
+		t.Fatalf("driver received %d values", len(written))
+	}
+	if b, ok := written[0].([]byte); !ok || !bytes.Equal(b, d[:]) {
+		t.Errorf("Digest was written as %#v", written[0])
+	}
+	if s, ok := written[1].(string); !ok || s != testDigestABC {
+		t.Errorf("DigestHex was written as %#v", written[1])
+	}
+	// each form reads back into either type
+	for i, v := range written {
+		var got Digest
+		var gotHex DigestHex
+		err1, err2 := got.Scan(v), gotHex.Scan(v)
+		if err1 != nil || err2 != nil || got.Digest != d ||
+			gotHex.Digest != d {
+			t.Errorf("TEST %d: Scan returned %v, %v", i+1, err1, err2)
+		}
+	}
+	for i, src := range []interface{}{nil, 42, d[:10], "abc"} {
+		var got DigestHex
+		if err := got.Scan(src); err == nil {
+			t.Errorf("TEST %d: Scan(%v) did not fail", i+1, src)
+		}
+	}
+} //                                                            Test_dgst_Write_
+
+// go test --run Test_dgst_Text_
+func Test_dgst_Text_(t *testing.T) {
+	// the wrappers keep the text forms of whirl.Digest
+	d := whirl.Sum512([]byte("abc"))
+	type row struct {
+		Raw Digest
+		Hex DigestHex
+	}
+	in := row{Digest{d}, DigestHex{d}}
+	data, err := json.Marshal(in)
+	expect := `{"Raw":"` + testDigestABC + `","Hex":"` + testDigestABC + `"}`
+	if err != nil || string(data) != expect {
+		t.Errorf("json.Marshal returned %s, %v", data, err)
+	}
+	var out row
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("json.Unmarshal returned %v", err)
+	}
+	for i, v := range []interface {
+		encoding.TextMarshaler
+		fmt.Stringer
+	}{Digest{d}, DigestHex{d}} {
+		text, err := v.MarshalText()
+		if err != nil || string(text) != testDigestABC {
+			t.Errorf("TEST %d: MarshalText returned %s, %v", i+1, text, err)
+		}
+		if s := fmt.Sprintf("%v", v); s != testDigestABC {
+			t.Errorf("TEST %d: %%v printed %s", i+1, s)
+		}
+		if s := v.String(); s != testDigestABC {
+			t.Errorf("TEST %d: String returned %s", i+1, s)
+		}
+	}
+	var got Digest
+	var gotHex DigestHex
+	err1 := got.UnmarshalText([]byte(testDigestABC))
+	err2 := gotHex.UnmarshalText([]byte(testDigestABC))
+	if err1 != nil || err2 != nil || got.Digest != d || gotHex.Digest != d {
+		t.Errorf("UnmarshalText returned %v, %v", err1, err2)
+	}
+} //                                                             Test_dgst_Text_
+
+// recorder is a minimal database/sql driver: it is the connector,
+// connection and statement at once, and appends the arguments of
+// every statement it executes to the slice it points to.
+type recorder struct {
+	args *[]driver.Value
+} //                                                                    recorder
+
+func (ob recorder) Begin() (driver.Tx, error) {
+	return nil, errors.New("recorder: no transactions")
+} //                                                                       Begin
+
+func (ob recorder) Close() error {
+	return nil
+} //                                                                       Close
+
+func (ob recorder) Connect(context.Context) (driver.Conn, error) {
+	return ob, nil
+} //                                                                     Connect
+
+func (ob recorder) Driver() driver.Driver {
+	return nil
+} //                                                                      Driver
+
+func (ob recorder) Exec(args []driver.Value) (driver.Result, error) {
+	*ob.args = append(*ob.args, args...)
+	return driver.RowsAffected(1), nil
+} //                                                                        Exec
+
+func (ob recorder) NumInput() int {
+	return -1
+} //                                                                    NumInput
+
+func (ob recorder) Prepare(query string) (driver.Stmt, error) {
+	return ob, nil
+} //                                                                     Prepare
+
+func (ob recorder) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("recorder: no queries")
+} //                                                                       Query
+
+// end